@@ -43,15 +43,77 @@ type DRPolicySpec struct {
 	// +kubebuilder:validation:XValidation:rule="size(self) == 2", message="drClusters requires a list of 2 clusters"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="drClusters is immutable"
 	DRClusters []string `json:"drClusters"`
+
+	// ReportOnly runs all validations of the policy and records their results in
+	// status, without the policy being treated as active. Secrets are not
+	// propagated, metrics are not set and the policy cannot be used by a
+	// DRPlacementControl while in this mode.
+	// +optional
+	ReportOnly bool `json:"reportOnly,omitempty"`
+
+	// ScheduleJitter offsets the start of the scheduled syncs of each PVC within
+	// the scheduling interval, by an amount derived from the name of the PVC, so
+	// that the PVCs of the policy do not all sync at once. Defaults to false,
+	// all PVCs sync at the start of the interval.
+	// +optional
+	ScheduleJitter bool `json:"scheduleJitter,omitempty"`
+}
+
+// ReplicationMode is how the PVCs of a pair of clusters of a DRPolicy replicate
+// +kubebuilder:validation:Enum=Metro;Async
+type ReplicationMode string
+
+const (
+	// ReplicationModeMetro is the synchronous replication of clusters in the same region
+	ReplicationModeMetro ReplicationMode = "Metro"
+
+	// ReplicationModeAsync is the asynchronous replication of clusters in different regions, every scheduling
+	// interval
+	ReplicationModeAsync ReplicationMode = "Async"
+)
+
+// ClusterPairReplication is the effective replication mode of a pair of clusters of a DRPolicy
+type ClusterPairReplication struct {
+	// Clusters of the pair, sorted by name
+	Clusters []string `json:"clusters"`
+
+	// ReplicationMode of the pair, Metro if both clusters are in the same region, Async otherwise
+	ReplicationMode ReplicationMode `json:"replicationMode"`
 }
 
 // DRPolicyStatus defines the observed state of DRPolicy
 type DRPolicyStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ClusterPairs lists the effective replication mode of each pair of the
+	// clusters of the policy whose DRClusters exist
+	// +optional
+	ClusterPairs []ClusterPairReplication `json:"clusterPairs,omitempty"`
 }
 
 const (
 	DRPolicyValidated string = `Validated`
+
+	// DRPolicyS3SecretPropagated is only present, and false, once the propagation of the s3 secrets of the
+	// DRPolicy to its clusters has failed the configured maximum number of attempts
+	DRPolicyS3SecretPropagated string = `S3SecretPropagated`
+
+	// DRPolicyVersionSkew is only present if version skew validation is enabled, and is true while the VolSync or
+	// Kubernetes versions of the clusters of the DRPolicy are further apart than allowed
+	DRPolicyVersionSkew string = `VersionSkew`
+
+	// DRPolicySchedulingIntervalSupported is only present if VolSync minimum scheduling intervals are configured,
+	// and is false while the scheduling interval of the DRPolicy is shorter than the minimum supported by the VolSync
+	// version of any of its clusters
+	DRPolicySchedulingIntervalSupported string = `SchedulingIntervalSupported`
+
+	// DRPolicyConflicting is only present if the DRPolicy conflict mode is warn, and is true while the DRPolicy
+	// overlaps the metro clusters of another DRPolicy
+	DRPolicyConflicting string = `Conflicting`
+
+	// DRPolicyRamenOpsNamespaceConsistent is only present if a RamenOps namespace is configured, and is false while
+	// the namespace is missing on any of the clusters of the DRPolicy, or unknown while it cannot be verified
+	DRPolicyRamenOpsNamespaceConsistent string = `RamenOpsNamespaceConsistent`
 )
 
 // +kubebuilder:object:root=true
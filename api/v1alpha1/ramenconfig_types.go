@@ -5,6 +5,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cfg "sigs.k8s.io/controller-runtime/pkg/config/v1alpha1"
 )
@@ -76,6 +77,311 @@ type S3StoreProfile struct {
 	CACertificates []byte `json:"caCertificates,omitempty"`
 }
 
+// PodExclusion identifies pods, typically storage infrastructure daemons, that
+// always mount a PVC and therefore must be disregarded when checking whether
+// the PVC is in use by an application.
+type PodExclusion struct {
+	// Namespace of the pods to disregard. Empty matches pods in any namespace.
+	//+optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PodSelector selects the pods to disregard by label. Empty matches all pods
+	// in the namespace. Either Namespace or PodSelector must be set.
+	//+optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// ClusterSetValidation requires the clusters of a DRPolicy to belong to compatible OCM ManagedClusterSets
+type ClusterSetValidation struct {
+	// Enabled requires all clusters of a DRPolicy to belong to the same ManagedClusterSet, as labeled on their
+	// ManagedClusters, unless each of them belongs to one of AllowedClusterSets. Defaults to false.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AllowedClusterSets are ManagedClusterSets whose clusters may be combined in a DRPolicy
+	//+optional
+	AllowedClusterSets []string `json:"allowedClusterSets,omitempty"`
+}
+
+// DRPolicyConflictMode selects how a DRPolicy overlapping the metro clusters of another DRPolicy is handled
+type DRPolicyConflictMode string
+
+const (
+	// DRPolicyConflictModeReject fails the validation of the conflicting DRPolicy
+	DRPolicyConflictModeReject DRPolicyConflictMode = "reject"
+
+	// DRPolicyConflictModeWarn validates the conflicting DRPolicy, reporting the conflict in its Conflicting
+	// condition
+	DRPolicyConflictModeWarn DRPolicyConflictMode = "warn"
+)
+
+// VersionSkewValidation compares the versions of VolSync, and optionally Kubernetes, of the clusters of a DRPolicy
+type VersionSkewValidation struct {
+	// Enabled reports, in the VersionSkew condition of each DRPolicy, whether the versions of its clusters are
+	// further apart than allowed. Defaults to false.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// MaxVolSyncMinorVersionSkew is the number of minor versions the VolSync versions of the clusters may differ by.
+	// Defaults to 1.
+	//+optional
+	MaxVolSyncMinorVersionSkew int `json:"maxVolSyncMinorVersionSkew,omitempty"`
+
+	// MaxKubernetesMinorVersionSkew is the number of minor versions the Kubernetes versions of the clusters may
+	// differ by. Defaults to 0, in which case Kubernetes versions are not compared.
+	//+optional
+	MaxKubernetesMinorVersionSkew int `json:"maxKubernetesMinorVersionSkew,omitempty"`
+}
+
+// VolSyncMinSchedulingInterval is the shortest scheduling interval supported by the VolSync versions starting with
+// Version
+type VolSyncMinSchedulingInterval struct {
+	// Version is the lowest VolSync version the minimum applies to, e.g. 0.8.0. A cluster is subject to the minimum
+	// of the highest version not newer than its VolSync version.
+	Version string `json:"version"`
+
+	// MinSchedulingInterval is the shortest scheduling interval supported, in the <num><m,h,d> format
+	MinSchedulingInterval string `json:"minSchedulingInterval"`
+}
+
+// VolSyncSecretOwner identifies the object that owns the rsync-tls pre-shared
+// key secrets used by VolSync, instead of the VRGs using them.
+type VolSyncSecretOwner struct {
+	// APIVersion of the owner, e.g. v1
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the owner, e.g. ConfigMap
+	Kind string `json:"kind"`
+
+	// Name of the owner, in the namespace of the secret if the owner is
+	// namespaced
+	Name string `json:"name"`
+}
+
+// VolSyncConfig is the VolSync configuration of a Ramen operator
+type VolSyncConfig struct {
+	// Disabled is used to disable VolSync usage in Ramen. Defaults to false.
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Default cephFS CSIDriver name used to enable ROX volumes. If this name matches
+	// the PVC's storageclass provisioner, a new storageclass will be created and the
+	// name of it passed to VolSync alongside the readOnly flag access mode.
+	CephFSCSIDriverName string `json:"cephFSCSIDriverName,omitempty"`
+
+	// destinationCopyMethod indicates the method that should be used when syncing
+	// from source to destination. Should be Snapshot/Direct
+	// default: Snapshot
+	DestinationCopyMethod string `json:"destinationCopyMethod,omitempty"`
+
+	// InUseCheckExclusions lists pods that are ignored when checking whether a
+	// PVC is in use, e.g. before running a final sync. A PVC mounted only by
+	// excluded pods is considered idle.
+	//+optional
+	InUseCheckExclusions []PodExclusion `json:"inUseCheckExclusions,omitempty"`
+
+	// ServerSideApply enables creating and updating ReplicationSources and
+	// ReplicationDestinations using server-side apply, so that Ramen owns only
+	// the fields it sets and does not overwrite fields set by others.
+	// default: false
+	//+optional
+	ServerSideApply bool `json:"serverSideApply,omitempty"`
+
+	// RetainedSnapshotContentCleanup enables deleting the VolumeSnapshotContents
+	// of VolumeSnapshots created for VolSync, when the VolumeSnapshots are deleted
+	// and their VolumeSnapshotClass has a deletionPolicy of Retain. When disabled,
+	// such VolumeSnapshotContents are left behind.
+	// default: false
+	//+optional
+	RetainedSnapshotContentCleanup bool `json:"retainedSnapshotContentCleanup,omitempty"`
+
+	// ProtectedPVCAnnotations lists the keys of PVC annotations to capture on the
+	// primary cluster and restore on the PVCs recreated from their replicated
+	// data, e.g. the key reference of volumes of encrypted CSI storage, without
+	// which these volumes cannot be decrypted after failover or relocation.
+	//+optional
+	ProtectedPVCAnnotations []string `json:"protectedPVCAnnotations,omitempty"`
+
+	// VerifyPVCDeletion enables verifying that the PVC of a ReplicationSource is
+	// gone after its final sync, e.g. not held by another finalizer, before
+	// reporting the final sync complete.
+	// default: false
+	//+optional
+	VerifyPVCDeletion bool `json:"verifyPVCDeletion,omitempty"`
+
+	// SourcePVCMissingAction is the action taken when the source PVC of an
+	// existing ReplicationSource is missing. Should be Wait/Delete, to wait for
+	// the PVC to be recreated keeping the ReplicationSource, or to delete the
+	// ReplicationSource.
+	// default: Wait
+	//+optional
+	//+kubebuilder:validation:Enum=Wait;Delete
+	SourcePVCMissingAction string `json:"sourcePVCMissingAction,omitempty"`
+
+	// MinProtectedPVCSize is the requested storage size below which PVCs are
+	// not protected using VolSync, i.e. neither ReplicationSources nor
+	// ReplicationDestinations are created for them. Such PVCs are reported in
+	// the status of the VRG as skipped for being too small.
+	// default: no minimum
+	//+optional
+	MinProtectedPVCSize *resource.Quantity `json:"minProtectedPVCSize,omitempty"`
+
+	// SnapshotRetentionSeconds is the minimum time the VolumeSnapshots protected
+	// by Ramen as restore points are retained. Ramen records when the retention
+	// of each snapshot expires, and does not release the snapshot for deletion
+	// before then, even if its PVC is no longer protected.
+	// default: 0, no minimum retention
+	//+optional
+	SnapshotRetentionSeconds int `json:"snapshotRetentionSeconds,omitempty"`
+
+	// ReplicationAnnotations are added to the ReplicationSources and
+	// ReplicationDestinations created by Ramen, e.g. to tag them for fleet
+	// governance. Annotations with the ramendr.openshift.io/ prefix, which are
+	// reserved for Ramen, are ignored.
+	//+optional
+	ReplicationAnnotations map[string]string `json:"replicationAnnotations,omitempty"`
+
+	// SnapshotImportEnabled, for peer clusters sharing a storage backend,
+	// records the backend handle of a recent VolumeSnapshot of each protected
+	// PVC in the status of the primary VRG. The peer cluster then restores the
+	// PVC by importing that snapshot, rather than from the image transferred
+	// by the VolSync mover.
+	// default: false
+	//+optional
+	SnapshotImportEnabled bool `json:"snapshotImportEnabled,omitempty"`
+
+	// MoverServiceAccountName is the name of the service account the movers of
+	// the ReplicationDestinations created by Ramen run as, e.g. one bound to
+	// tightly scoped RBAC. The service account must exist in the namespace of
+	// each protected PVC.
+	// default: the service account VolSync creates for the mover
+	//+optional
+	MoverServiceAccountName string `json:"moverServiceAccountName,omitempty"`
+
+	// MoverServiceAccountFallbackNames are the names of the service accounts
+	// tried in order, for the movers of the ReplicationDestinations created by
+	// Ramen, while the service account named by MoverServiceAccountName does
+	// not exist in the namespace of a protected PVC, e.g. as its provisioning
+	// lags. The first that exists is used.
+	//+optional
+	MoverServiceAccountFallbackNames []string `json:"moverServiceAccountFallbackNames,omitempty"`
+
+	// PSKSecretStore is where the rsync-tls pre-shared key secrets used by
+	// VolSync come from. Should be Native/External. Native secrets are
+	// propagated by Ramen from the hub. External secrets are synced into the
+	// namespace of each protected PVC by an external secret store, e.g. from
+	// HashiCorp Vault by the Secrets Store CSI driver or the External Secrets
+	// Operator, and Ramen only validates that they are present.
+	// default: Native
+	//+optional
+	PSKSecretStore string `json:"pskSecretStore,omitempty"`
+
+	// PSKSecretOwner is the object that owns the Native pre-shared key secrets,
+	// e.g. a longer lived secret manager, so that the lifecycle of a secret
+	// shared by the VRGs of a namespace is decoupled from any one of them. The
+	// owner must exist on the managed cluster, in the namespace of the VRG if
+	// it is namespaced, and Ramen must be allowed to get it.
+	// default: the VRG
+	//+optional
+	PSKSecretOwner *VolSyncSecretOwner `json:"pskSecretOwner,omitempty"`
+
+	// FinalSyncTimeoutSeconds is the time the final sync of a PVC, e.g. on
+	// relocation, is waited for once its mover starts syncing. A final sync
+	// that does not complete in time, e.g. as the mover is stuck, is reported
+	// as timed out in the status of the VRG, with the latest mover status.
+	// default: 21600, i.e. 6 hours
+	//+optional
+	FinalSyncTimeoutSeconds int `json:"finalSyncTimeoutSeconds,omitempty"`
+
+	// InitialSyncImmediate triggers the first sync of each ReplicationSource
+	// right after it is created, rather than on the next tick of its
+	// schedule, so that a newly protected PVC has a restore point without
+	// waiting for a full scheduling interval. The ReplicationSource then
+	// syncs on its schedule.
+	// default: false
+	//+optional
+	InitialSyncImmediate bool `json:"initialSyncImmediate,omitempty"`
+
+	// FieldManager is the field manager of the ReplicationSources,
+	// ReplicationDestinations and other objects written for VolSync, so that
+	// the ownership of their managed fields is attributable to Ramen.
+	// default: ramen-volsync
+	//+optional
+	FieldManager string `json:"fieldManager,omitempty"`
+
+	// MaxConcurrentSyncs caps the number of ReplicationSources of a VRG that
+	// sync at once, so that the movers of many PVCs with the same schedule do
+	// not all run at the same time. ReplicationSources beyond the cap are
+	// paused until a running sync completes, those that synced least
+	// recently being resumed first. Final syncs are never paused, and a
+	// ReplicationSource paused by anyone but Ramen is left paused. Ignored if
+	// VolSync does not support pausing a ReplicationSource.
+	// default: 0, i.e. unlimited
+	//+optional
+	MaxConcurrentSyncs int `json:"maxConcurrentSyncs,omitempty"`
+
+	// MoverFailureLogLines is the number of the last lines of the mover logs
+	// of a failed sync that are recorded in the status of its ProtectedPVC.
+	// A negative value records none.
+	// default: 10
+	//+optional
+	MoverFailureLogLines int `json:"moverFailureLogLines,omitempty"`
+
+	// RestoredPVCMode is whether the PVCs restored from snapshots are usable
+	// immediately, or quarantined until their data is scanned. Should be
+	// Immediate/Quarantine. Quarantined PVCs are labeled
+	// ramendr.openshift.io/quarantined, for an admission policy or workload
+	// controller to keep them from being mounted, until they are annotated
+	// ramendr.openshift.io/quarantine-released: "true", once the scan
+	// completes, when the label is removed.
+	// default: Immediate
+	//+optional
+	RestoredPVCMode string `json:"restoredPVCMode,omitempty"`
+
+	// StorageClassAliases maps the storage class names recorded for the
+	// protected PVCs to the names of their current equivalents, e.g. when
+	// platform teams rename storage classes on a cluster upgrade, so that
+	// PVCs protected before the rename are still replicated and restored.
+	// Aliases are followed transitively, for classes renamed more than once.
+	//+optional
+	StorageClassAliases map[string]string `json:"storageClassAliases,omitempty"`
+
+	// InitialSyncThroughput is the throughput, in bytes per second, assumed
+	// to estimate the duration of the initial sync of a newly protected PVC
+	// when no sync of the VRG has reported its throughput yet, e.g. 50Mi.
+	// default: 50Mi
+	//+optional
+	InitialSyncThroughput *resource.Quantity `json:"initialSyncThroughput,omitempty"`
+
+	// DeferRDCleanup keeps the ReplicationDestinations of a VRG taking over as
+	// primary, paused, until the hub confirms the takeover, instead of
+	// deleting them as soon as the ReplicationSources are created. The hub
+	// confirms the takeover once the VRG reports it is primary and the
+	// replication of each of its VolSync PVCs is ready. A transition aborted
+	// before then resumes the ReplicationDestinations, with their state.
+	// default: false
+	//+optional
+	DeferRDCleanup bool `json:"deferRDCleanup,omitempty"`
+
+	// FinalSyncQuiesceWorkloads scales down to zero replicas the Deployments
+	// and StatefulSets whose pods still use a PVC whose final sync is
+	// requested, e.g. for discovered apps that are not removed by the hub, so
+	// that the final sync can proceed. The workloads holding the PVC are
+	// logged regardless. The replica count of a scaled down workload is
+	// recorded in its ramendr.openshift.io/quiesced-replicas annotation, and
+	// restored once the VRG is primary with no final sync prepared or run,
+	// e.g. as the relocation is canceled.
+	// default: false
+	//+optional
+	FinalSyncQuiesceWorkloads bool `json:"finalSyncQuiesceWorkloads,omitempty"`
+
+	// ProtectedBytesAccounting is how the data protected by the
+	// ReplicationDestinations of a VRG is totaled. Should be Requested/Used.
+	// Requested sums the capacity of the ReplicationDestinations, and Used
+	// sums the restore size of their latest images, where the snapshot
+	// reports it, or their capacity otherwise.
+	// default: Requested
+	//+optional
+	ProtectedBytesAccounting string `json:"protectedBytesAccounting,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 
 // RamenConfig is the Schema for the ramenconfig API
@@ -91,6 +397,13 @@ type RamenConfig struct {
 	// Map of S3 store profiles
 	S3StoreProfiles []S3StoreProfile `json:"s3StoreProfiles,omitempty"`
 
+	// Hosts the endpoints of S3 store profiles may point at, e.g. to keep DR
+	// data from being exfiltrated to an unapproved S3 store. A profile whose
+	// endpoint host is not listed is rejected. Defaults to empty, which allows
+	// any host.
+	//+optional
+	S3EndpointAllowlist []string `json:"s3EndpointAllowlist,omitempty"`
+
 	// MaxConcurrentReconciles is the maximum number of concurrent Reconciles which can be run.
 	// Defaults to 1.
 	MaxConcurrentReconciles int `json:"maxConcurrentReconciles,omitempty"`
@@ -120,23 +433,15 @@ type RamenConfig struct {
 
 		// cluster service version name
 		ClusterServiceVersionName string `json:"clusterServiceVersionName,omitempty"`
+
+		// Number of consecutive failed attempts to propagate the s3 secrets of a DRPolicy to its dr-clusters,
+		// after which the DRPolicy reports S3SecretPropagationFailed and the propagation is no longer retried
+		// until the DRPolicy, its secrets or dr-clusters change. Defaults to 0, retried indefinitely.
+		S3SecretPropagationMaxAttempts int `json:"s3SecretPropagationMaxAttempts,omitempty"`
 	} `json:"drClusterOperator,omitempty"`
 
 	// VolSync configuration
-	VolSync struct {
-		// Disabled is used to disable VolSync usage in Ramen. Defaults to false.
-		Disabled bool `json:"disabled,omitempty"`
-
-		// Default cephFS CSIDriver name used to enable ROX volumes. If this name matches
-		// the PVC's storageclass provisioner, a new storageclass will be created and the
-		// name of it passed to VolSync alongside the readOnly flag access mode.
-		CephFSCSIDriverName string `json:"cephFSCSIDriverName,omitempty"`
-
-		// destinationCopyMethod indicates the method that should be used when syncing
-		// from source to destination. Should be Snapshot/Direct
-		// default: Snapshot
-		DestinationCopyMethod string `json:"destinationCopyMethod,omitempty"`
-	} `json:"volSync,omitempty"`
+	VolSync VolSyncConfig `json:"volSync,omitempty"`
 
 	KubeObjectProtection struct {
 		// Disabled is used to disable KubeObjectProtection usage in Ramen.
@@ -154,8 +459,53 @@ type RamenConfig struct {
 	// Unprotect deleted or deselected PVCs
 	VolumeUnprotectionEnabled bool `json:"volumeUnprotectionEnabled,omitempty"`
 
+	// Capture events of protected PVCs into the s3 stores along with their cluster data
+	PVCEventsCapture struct {
+		// Enabled captures the recent events of a PVC each time its cluster data is uploaded
+		Enabled bool `json:"enabled,omitempty"`
+		// Maximum number of most recent events captured per PVC. Defaults to 20.
+		MaxEvents int `json:"maxEvents,omitempty"`
+		// Maximum age in seconds of the events captured. Defaults to 86400 (1 day).
+		MaxAgeSeconds int `json:"maxAgeSeconds,omitempty"`
+	} `json:"pvcEventsCapture,omitempty"`
+
 	// RamenOpsNamespace is the namespace where resources for unmanaged apps are created
 	RamenOpsNamespace string `json:"ramenOpsNamespace,omitempty"`
+
+	// MaxDRPoliciesPerCluster is the maximum number of DRPolicies that may reference a single DRCluster.
+	// A DRPolicy that would exceed it fails validation. Defaults to 0, unlimited.
+	MaxDRPoliciesPerCluster int `json:"maxDRPoliciesPerCluster,omitempty"`
+
+	// MinSchedulingInterval is the shortest scheduling interval a DRPolicy may request, in the same <num><m,h,d>
+	// format. A DRPolicy requesting a shorter interval fails validation. Defaults to unset, no minimum.
+	MinSchedulingInterval string `json:"minSchedulingInterval,omitempty"`
+
+	// Notify an external system of DRPolicy validation transitions
+	DRPolicyNotification struct {
+		// URL a JSON notification is POSTed to each time a DRPolicy becomes validated or invalid.
+		// Notifications are disabled if empty.
+		URL string `json:"url,omitempty"`
+		// Timeout in seconds of each notification attempt. Defaults to 10.
+		TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+		// Number of times a failed notification is retried. Defaults to 3.
+		Retries int `json:"retries,omitempty"`
+	} `json:"drPolicyNotification,omitempty"`
+
+	// Validate the ManagedClusterSets of the clusters of each DRPolicy
+	DRPolicyClusterSetValidation ClusterSetValidation `json:"drPolicyClusterSetValidation,omitempty"`
+
+	// Validate the skew of the VolSync and Kubernetes versions of the clusters of each DRPolicy
+	DRPolicyVersionSkewValidation VersionSkewValidation `json:"drPolicyVersionSkewValidation,omitempty"`
+
+	// Shortest scheduling intervals supported by VolSync versions. The SchedulingIntervalSupported condition of each
+	// DRPolicy reports whether its scheduling interval is supported by the VolSync version of each of its clusters.
+	// Defaults to unset, in which case the condition is not reported.
+	VolSyncMinSchedulingIntervals []VolSyncMinSchedulingInterval `json:"volSyncMinSchedulingIntervals,omitempty"`
+
+	// How a DRPolicy overlapping the metro clusters of another DRPolicy is handled, either "reject" or "warn".
+	// Defaults to "reject".
+	//+kubebuilder:validation:Enum=reject;warn
+	DRPolicyConflictMode DRPolicyConflictMode `json:"drPolicyConflictMode,omitempty"`
 }
 
 func init() {
@@ -56,6 +56,12 @@ type VRGAsyncSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`^\d+[mhd]$`
 	SchedulingInterval string `json:"schedulingInterval"`
+
+	// ScheduleJitter offsets the start of the scheduled syncs of each PVC
+	// within the scheduling interval, by an amount derived from the name of
+	// the PVC, when using VolSync.
+	//+optional
+	ScheduleJitter bool `json:"scheduleJitter,omitempty"`
 }
 
 // VRGSyncSpec has the parameters associated with MetroDR
@@ -86,8 +92,36 @@ type VolSyncSpec struct {
 
 	// disabled when set, all the VolSync code is bypassed. Default is 'false'
 	Disabled bool `json:"disabled,omitempty"`
+
+	// requiredRestoredPVCLabels are labels the selectors of the workload need
+	// on its PVCs, in addition to the labels of the protected PVCs. They are
+	// set on the PVCs restored from snapshots, and a PVC is not reported as
+	// restored until it has them all.
+	//+optional
+	RequiredRestoredPVCLabels map[string]string `json:"requiredRestoredPVCLabels,omitempty"`
+
+	// triggerMode selects how the syncs of the ReplicationSources are
+	// triggered. Scheduled syncs on the scheduling interval. Manual syncs only
+	// once on creation, and then whenever the manual trigger of a
+	// ReplicationSource is changed, e.g. by an external orchestrator. Final
+	// syncs are triggered regardless. Default is Scheduled.
+	//+optional
+	TriggerMode VolSyncTriggerMode `json:"triggerMode,omitempty"`
 }
 
+// VolSyncTriggerMode is how the syncs of VolSync ReplicationSources are triggered
+// +kubebuilder:validation:Enum=Scheduled;Manual
+type VolSyncTriggerMode string
+
+// These are the valid values for VolSyncTriggerMode
+const (
+	// VolSyncTriggerModeScheduled syncs on the scheduling interval
+	VolSyncTriggerModeScheduled = VolSyncTriggerMode("Scheduled")
+
+	// VolSyncTriggerModeManual syncs only on a change of the manual trigger
+	VolSyncTriggerModeManual = VolSyncTriggerMode("Manual")
+)
+
 // VRGAction which will be either a Failover or Relocate
 // +kubebuilder:validation:Enum=Failover;Relocate
 type VRGAction string
@@ -284,6 +318,64 @@ type ProtectedPVC struct {
 
 	// Bytes transferred per sync, if protected in async mode only
 	LastSyncBytes *int64 `json:"lastSyncBytes,omitempty"`
+
+	// SnapshotImport is a recent snapshot of the PVC on a storage backend
+	// shared with the peer cluster, if protected in the volsync mode with
+	// snapshot import enabled
+	//+optional
+	SnapshotImport *VolSyncSnapshotImport `json:"snapshotImport,omitempty"`
+
+	// RemoteAddress is the address of the ReplicationDestination on the peer
+	// cluster that the ReplicationSource of the PVC replicates to, if protected
+	// in the volsync mode
+	//+optional
+	RemoteAddress string `json:"remoteAddress,omitempty"`
+
+	// LastSyncFailure describes the mover of the most recent synchronization
+	// of the PVC, if it failed, or is failing, and the PVC is protected in the
+	// volsync mode
+	//+optional
+	LastSyncFailure *VolSyncMoverFailure `json:"lastSyncFailure,omitempty"`
+
+	// InitialSyncEstimate is the best-effort estimate of the duration of the
+	// initial synchronization of the PVC, until it completes, if protected in
+	// the volsync mode. It is derived from the requested storage of the PVC
+	// and the throughput observed by prior synchronizations of the VRG, or a
+	// configured default throughput
+	//+optional
+	InitialSyncEstimate *metav1.Duration `json:"initialSyncEstimate,omitempty"`
+}
+
+// VolSyncMoverFailure describes the mover of a failed VolSync synchronization,
+// so that the failure can be diagnosed after the transient mover pod is gone
+type VolSyncMoverFailure struct {
+	// MoverPod is the name of the mover pod of the failed synchronization, in
+	// the namespace of the PVC
+	//+optional
+	MoverPod string `json:"moverPod,omitempty"`
+
+	// Reason the mover container terminated, or is waiting, e.g. Error or
+	// CrashLoopBackOff, or the mover result reported by VolSync if the mover
+	// pod is not found
+	Reason string `json:"reason"`
+
+	// Logs are the last lines of the mover logs, as reported by VolSync
+	//+optional
+	Logs string `json:"logs,omitempty"`
+}
+
+// VolSyncSnapshotImport identifies a snapshot on a storage backend shared by
+// the peer clusters, to be imported as a pre-provisioned VolumeSnapshot
+type VolSyncSnapshotImport struct {
+	// SnapshotHandle of the snapshot on the storage backend
+	SnapshotHandle string `json:"snapshotHandle"`
+
+	// Driver of the CSI storage backend of the snapshot
+	Driver string `json:"driver"`
+
+	// VolumeSnapshotClassName the snapshot was taken with
+	//+optional
+	VolumeSnapshotClassName string `json:"volumeSnapshotClassName,omitempty"`
 }
 
 type KubeObjectsCaptureIdentifier struct {
@@ -333,6 +425,15 @@ type VolumeReplicationGroupStatus struct {
 	// successful synchronization of all PVCs
 	//+optional
 	LastGroupSyncBytes *int64 `json:"lastGroupSyncBytes,omitempty"`
+
+	// protectedDataBytes is the total storage requested by all protected PVCs
+	//+optional
+	ProtectedDataBytes *int64 `json:"protectedDataBytes,omitempty"`
+
+	// objectStoreBytes is the total size of the objects stored for the VRG in
+	// all its S3 stores, as last listed
+	//+optional
+	ObjectStoreBytes *int64 `json:"objectStoreBytes,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -35,6 +35,46 @@ func (in *ClusterMaintenanceMode) DeepCopy() *ClusterMaintenanceMode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPairReplication) DeepCopyInto(out *ClusterPairReplication) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPairReplication.
+func (in *ClusterPairReplication) DeepCopy() *ClusterPairReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPairReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSetValidation) DeepCopyInto(out *ClusterSetValidation) {
+	*out = *in
+	if in.AllowedClusterSets != nil {
+		in, out := &in.AllowedClusterSets, &out.AllowedClusterSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSetValidation.
+func (in *ClusterSetValidation) DeepCopy() *ClusterSetValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSetValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRCluster) DeepCopyInto(out *DRCluster) {
 	*out = *in
@@ -380,6 +420,13 @@ func (in *DRPolicyStatus) DeepCopyInto(out *DRPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterPairs != nil {
+		in, out := &in.ClusterPairs, &out.ClusterPairs
+		*out = make([]ClusterPairReplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicyStatus.
@@ -611,6 +658,22 @@ func (in *PlacementDecision) DeepCopy() *PlacementDecision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodExclusion) DeepCopyInto(out *PodExclusion) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodExclusion.
+func (in *PodExclusion) DeepCopy() *PodExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(PodExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProtectedPVC) DeepCopyInto(out *ProtectedPVC) {
 	*out = *in
@@ -661,6 +724,21 @@ func (in *ProtectedPVC) DeepCopyInto(out *ProtectedPVC) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SnapshotImport != nil {
+		in, out := &in.SnapshotImport, &out.SnapshotImport
+		*out = new(VolSyncSnapshotImport)
+		**out = **in
+	}
+	if in.LastSyncFailure != nil {
+		in, out := &in.LastSyncFailure, &out.LastSyncFailure
+		*out = new(VolSyncMoverFailure)
+		**out = **in
+	}
+	if in.InitialSyncEstimate != nil {
+		in, out := &in.InitialSyncEstimate, &out.InitialSyncEstimate
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedPVC.
@@ -786,10 +864,24 @@ func (in *RamenConfig) DeepCopyInto(out *RamenConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.S3EndpointAllowlist != nil {
+		in, out := &in.S3EndpointAllowlist, &out.S3EndpointAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.DrClusterOperator = in.DrClusterOperator
-	out.VolSync = in.VolSync
+	in.VolSync.DeepCopyInto(&out.VolSync)
 	out.KubeObjectProtection = in.KubeObjectProtection
 	out.MultiNamespace = in.MultiNamespace
+	out.PVCEventsCapture = in.PVCEventsCapture
+	out.DRPolicyNotification = in.DRPolicyNotification
+	in.DRPolicyClusterSetValidation.DeepCopyInto(&out.DRPolicyClusterSetValidation)
+	out.DRPolicyVersionSkewValidation = in.DRPolicyVersionSkewValidation
+	if in.VolSyncMinSchedulingIntervals != nil {
+		in, out := &in.VolSyncMinSchedulingIntervals, &out.VolSyncMinSchedulingIntervals
+		*out = make([]VolSyncMinSchedulingInterval, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RamenConfig.
@@ -943,6 +1035,112 @@ func (in *VRGSyncSpec) DeepCopy() *VRGSyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionSkewValidation) DeepCopyInto(out *VersionSkewValidation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionSkewValidation.
+func (in *VersionSkewValidation) DeepCopy() *VersionSkewValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionSkewValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncConfig) DeepCopyInto(out *VolSyncConfig) {
+	*out = *in
+	if in.InUseCheckExclusions != nil {
+		in, out := &in.InUseCheckExclusions, &out.InUseCheckExclusions
+		*out = make([]PodExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProtectedPVCAnnotations != nil {
+		in, out := &in.ProtectedPVCAnnotations, &out.ProtectedPVCAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinProtectedPVCSize != nil {
+		in, out := &in.MinProtectedPVCSize, &out.MinProtectedPVCSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReplicationAnnotations != nil {
+		in, out := &in.ReplicationAnnotations, &out.ReplicationAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MoverServiceAccountFallbackNames != nil {
+		in, out := &in.MoverServiceAccountFallbackNames, &out.MoverServiceAccountFallbackNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PSKSecretOwner != nil {
+		in, out := &in.PSKSecretOwner, &out.PSKSecretOwner
+		*out = new(VolSyncSecretOwner)
+		**out = **in
+	}
+	if in.StorageClassAliases != nil {
+		in, out := &in.StorageClassAliases, &out.StorageClassAliases
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InitialSyncThroughput != nil {
+		in, out := &in.InitialSyncThroughput, &out.InitialSyncThroughput
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncConfig.
+func (in *VolSyncConfig) DeepCopy() *VolSyncConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncMinSchedulingInterval) DeepCopyInto(out *VolSyncMinSchedulingInterval) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncMinSchedulingInterval.
+func (in *VolSyncMinSchedulingInterval) DeepCopy() *VolSyncMinSchedulingInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncMinSchedulingInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncMoverFailure) DeepCopyInto(out *VolSyncMoverFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncMoverFailure.
+func (in *VolSyncMoverFailure) DeepCopy() *VolSyncMoverFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncMoverFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolSyncReplicationDestinationSpec) DeepCopyInto(out *VolSyncReplicationDestinationSpec) {
 	*out = *in
@@ -975,6 +1173,36 @@ func (in *VolSyncReplicationSourceSpec) DeepCopy() *VolSyncReplicationSourceSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncSecretOwner) DeepCopyInto(out *VolSyncSecretOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSecretOwner.
+func (in *VolSyncSecretOwner) DeepCopy() *VolSyncSecretOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncSecretOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncSnapshotImport) DeepCopyInto(out *VolSyncSnapshotImport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSnapshotImport.
+func (in *VolSyncSnapshotImport) DeepCopy() *VolSyncSnapshotImport {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncSnapshotImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolSyncSpec) DeepCopyInto(out *VolSyncSpec) {
 	*out = *in
@@ -985,6 +1213,13 @@ func (in *VolSyncSpec) DeepCopyInto(out *VolSyncSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RequiredRestoredPVCLabels != nil {
+		in, out := &in.RequiredRestoredPVCLabels, &out.RequiredRestoredPVCLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSpec.
@@ -1135,6 +1370,16 @@ func (in *VolumeReplicationGroupStatus) DeepCopyInto(out *VolumeReplicationGroup
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ProtectedDataBytes != nil {
+		in, out := &in.ProtectedDataBytes, &out.ProtectedDataBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ObjectStoreBytes != nil {
+		in, out := &in.ObjectStoreBytes, &out.ObjectStoreBytes
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationGroupStatus.
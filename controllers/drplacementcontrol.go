@@ -44,6 +44,7 @@ var (
 	WaitForSourceCluster                error = errorswrapper.New("Waiting for primary to provide Protected PVCs...")
 	WaitForVolSyncManifestWorkCreation  error = errorswrapper.New("Waiting for VolSync ManifestWork to be created...")
 	WaitForVolSyncRDInfoAvailibility    error = errorswrapper.New("Waiting for VolSync RDInfo...")
+	WaitForVolSyncTakeover              error = errorswrapper.New("Waiting for VolSync takeover to be confirmed...")
 )
 
 type DRType string
@@ -916,6 +917,12 @@ func (d *DRPCInstance) ensureActionCompleted(srcCluster string) (bool, error) {
 }
 
 func (d *DRPCInstance) ensureCleanupAndVolSyncReplicationSetup(srcCluster string) error {
+	// With RD cleanup deferred, the RDs on the primary are kept until its takeover is confirmed, for an aborted
+	// transition to roll back without losing them
+	if !d.volSyncTakeoverConfirmed(srcCluster) {
+		return WaitForVolSyncTakeover
+	}
+
 	// If we have VolSync replication, this is the perfect time to reset the RDSpec
 	// on the primary. This will cause the RD to be cleared on the primary
 	err := d.ResetVolSyncRDOnPrimary(srcCluster)
@@ -1614,6 +1621,7 @@ func (d *DRPCInstance) generateVRGSpecAsync() *rmn.VRGAsyncSpec {
 			ReplicationClassSelector:    d.drPolicy.Spec.ReplicationClassSelector,
 			VolumeSnapshotClassSelector: d.drPolicy.Spec.VolumeSnapshotClassSelector,
 			SchedulingInterval:          d.drPolicy.Spec.SchedulingInterval,
+			ScheduleJitter:              d.drPolicy.Spec.ScheduleJitter,
 		}
 	}
 
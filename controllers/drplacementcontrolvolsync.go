@@ -5,11 +5,13 @@ package controllers
 
 import (
 	"fmt"
+	"reflect"
 
 	rmn "github.com/ramendr/ramen/api/v1alpha1"
 	rmnutil "github.com/ramendr/ramen/controllers/util"
 	"github.com/ramendr/ramen/controllers/volsync"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 )
 
 func (d *DRPCInstance) EnsureVolSyncReplicationSetup(homeCluster string) error {
@@ -136,8 +138,8 @@ func (d *DRPCInstance) ensureVolSyncReplicationDestination(srcCluster string) er
 }
 
 // containsMismatchVolSyncPVCs returns true if a VolSync protected pvc in the source VRG is not
-// found in the destination VRG RDSpecs.  Since we never delete protected PVCS from the source VRG,
-// we don't check for other case - a protected PVC in destination not found in the source.
+// found in the destination VRG RDSpecs, or its snapshot to import differs.  Since we never delete protected PVCS
+// from the source VRG, we don't check for other case - a protected PVC in destination not found in the source.
 func (d *DRPCInstance) containsMismatchVolSyncPVCs(srcVRG *rmn.VolumeReplicationGroup,
 	dstVRG *rmn.VolumeReplicationGroup,
 ) bool {
@@ -149,7 +151,8 @@ func (d *DRPCInstance) containsMismatchVolSyncPVCs(srcVRG *rmn.VolumeReplication
 		for _, rdSpec := range dstVRG.Spec.VolSync.RDSpec {
 			if protectedPVC.Name == rdSpec.ProtectedPVC.Name &&
 				protectedPVC.Namespace == rdSpec.ProtectedPVC.Namespace {
-				return false
+				// The source takes a new snapshot to import each scheduling interval
+				return !reflect.DeepEqual(protectedPVC.SnapshotImport, rdSpec.ProtectedPVC.SnapshotImport)
 			}
 		}
 
@@ -324,6 +327,47 @@ func (d *DRPCInstance) createVolSyncDestManifestWork(clusterToSkip string) error
 	return nil
 }
 
+// volSyncTakeoverConfirmed returns true unless RD cleanup is deferred, and the VRG of the cluster, as last reported,
+// has yet to report that it is primary and that the replication of each of its VolSync PVCs is ready, or has its
+// RDSpec already cleared
+func (d *DRPCInstance) volSyncTakeoverConfirmed(clusterName string) bool {
+	if d.volSyncDisabled || !d.ramenConfig.VolSync.DeferRDCleanup {
+		return true
+	}
+
+	vrg, found := d.vrgs[clusterName]
+	if !found {
+		d.log.Info("VRG not found, VolSync takeover not confirmed", "cluster", clusterName)
+
+		return false
+	}
+
+	if len(vrg.Spec.VolSync.RDSpec) == 0 {
+		return true
+	}
+
+	if vrg.Status.State != rmn.PrimaryState {
+		d.log.Info("VRG not primary yet, VolSync takeover not confirmed", "cluster", clusterName)
+
+		return false
+	}
+
+	for _, protectedPVC := range vrg.Status.ProtectedPVCs {
+		if !protectedPVC.ProtectedByVolSync {
+			continue
+		}
+
+		if !meta.IsStatusConditionTrue(protectedPVC.Conditions, volsync.ConditionTypeReplicationReady) {
+			d.log.Info("VolSync replication not ready, VolSync takeover not confirmed", "cluster", clusterName,
+				"pvc", protectedPVC.Namespace+"/"+protectedPVC.Name)
+
+			return false
+		}
+	}
+
+	return true
+}
+
 func (d *DRPCInstance) ResetVolSyncRDOnPrimary(clusterName string) error {
 	if d.volSyncDisabled {
 		d.log.Info("VolSync is disabled")
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"reflect"
+	"sort"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// DRPolicyClusterPairs returns the effective replication mode of each pair of the clusters of the DRPolicy, pairs
+// being ordered by cluster names. Clusters of a pair in the same metro region, as determined by
+// dRPolicySupportsMetro, replicate synchronously, other pairs asynchronously. Pairs with a cluster whose DRCluster
+// does not exist are left out, as the region of the cluster is not known.
+func DRPolicyClusterPairs(drpolicy *ramen.DRPolicy, drclusters []ramen.DRCluster) []ramen.ClusterPairReplication {
+	_, metroMap := dRPolicySupportsMetro(drpolicy, drclusters)
+
+	metroRegions := map[string]ramen.Region{}
+
+	for region, clusterNames := range metroMap {
+		for _, clusterName := range clusterNames {
+			metroRegions[clusterName] = region
+		}
+	}
+
+	clusterNames := []string{}
+
+	for _, clusterName := range util.DRPolicyClusterNames(drpolicy) {
+		for i := range drclusters {
+			if drclusters[i].Name == clusterName {
+				clusterNames = append(clusterNames, clusterName)
+
+				break
+			}
+		}
+	}
+
+	sort.Strings(clusterNames)
+
+	pairs := []ramen.ClusterPairReplication{}
+
+	for i := range clusterNames {
+		for j := i + 1; j < len(clusterNames); j++ {
+			mode := ramen.ReplicationModeAsync
+
+			region, isMetro := metroRegions[clusterNames[i]]
+			if isMetro && region == metroRegions[clusterNames[j]] {
+				mode = ramen.ReplicationModeMetro
+			}
+
+			pairs = append(pairs, ramen.ClusterPairReplication{
+				Clusters:        []string{clusterNames[i], clusterNames[j]},
+				ReplicationMode: mode,
+			})
+		}
+	}
+
+	return pairs
+}
+
+// clusterPairsReconcile records the effective replication mode of each pair of the clusters of the DRPolicy in its
+// status
+func clusterPairsReconcile(u *drpolicyUpdater, drclusters *ramen.DRClusterList) error {
+	pairs := DRPolicyClusterPairs(u.object, drclusters.Items)
+	if len(pairs) == 0 {
+		pairs = nil
+	}
+
+	if reflect.DeepEqual(u.object.Status.ClusterPairs, pairs) {
+		return nil
+	}
+
+	u.object.Status.ClusterPairs = pairs
+
+	return u.statusUpdate()
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// ReasonNoConflicts is set when the DRPolicy does not overlap the metro clusters of any other DRPolicy
+const ReasonNoConflicts = "NoConflicts"
+
+// conflictReconcile sets the Conflicting condition of the DRPolicy, if the conflict mode is warn, or removes the
+// condition otherwise, as a conflicting DRPolicy then fails its validation instead
+func (r *DRPolicyReconciler) conflictReconcile(u *drpolicyUpdater,
+	drclusters *ramen.DRClusterList,
+	mode ramen.DRPolicyConflictMode,
+) error {
+	if mode != ramen.DRPolicyConflictModeWarn {
+		if !meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRPolicyConflicting) {
+			return nil
+		}
+
+		return u.statusUpdate()
+	}
+
+	drpolicies, err := util.GetAllDRPolicies(u.ctx, r.APIReader)
+	if err != nil {
+		return fmt.Errorf("drpolicies list: %w", err)
+	}
+
+	if err := hasConflictingDRPolicy(u.object, drclusters, drpolicies); err != nil {
+		util.ReportIfNotPresent(r.eventRecorder, u.object, corev1.EventTypeWarning,
+			util.EventReasonDRPolicyConflict, err.Error())
+
+		return u.statusConditionSet(ramen.DRPolicyConflicting, metav1.ConditionTrue, ReasonDRPolicyConflict,
+			err.Error())
+	}
+
+	return u.statusConditionSet(ramen.DRPolicyConflicting, metav1.ConditionFalse, ReasonNoConflicts,
+		"drpolicy does not overlap the metro clusters of another drpolicy")
+}
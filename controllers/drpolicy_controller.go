@@ -7,10 +7,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	clrapiv1beta2 "github.com/open-cluster-management-io/api/cluster/v1beta2"
+	ocmclv1 "github.com/open-cluster-management/api/cluster/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -36,7 +42,15 @@ type DRPolicyReconciler struct {
 	Log               logr.Logger
 	Scheme            *runtime.Scheme
 	ObjectStoreGetter ObjectStoreGetter
+	MCVGetter         util.ManagedClusterViewGetter
 	RateLimiter       *workqueue.RateLimiter
+	eventRecorder     *util.EventReporter
+	// validationStartTimes holds the time of the first reconcile of each DRPolicy not yet validated, by UID
+	validationStartTimes sync.Map
+	// secretPropagationAttempts holds the failed s3 secret propagations of the generation of each DRPolicy, by UID,
+	// until its secrets or DRClusters change
+	secretPropagationAttempts sync.Map
+	notifier                  *drPolicyNotifier
 }
 
 // ReasonValidationFailed is set when the DRPolicy could not be validated or is not valid
@@ -48,6 +62,19 @@ const ReasonDRClusterNotFound = "DRClusterNotFound"
 // ReasonDRClustersUnavailable is set when the DRPolicy has none of the referenced DRCluster(s) are in a validated state
 const ReasonDRClustersUnavailable = "DRClustersUnavailable"
 
+// ReasonDRPolicyConflict is set when the DRPolicy conflicts with another DRPolicy
+const ReasonDRPolicyConflict = "DRPolicyConflict"
+
+// ReasonDRPolicyLimitExceeded is set when the DRPolicy exceeds the maximum number of DRPolicies of a DRCluster
+const ReasonDRPolicyLimitExceeded = "DRPolicyLimitExceeded"
+
+// ReasonClusterSetMismatch is set when the DRClusters of the DRPolicy belong to incompatible ManagedClusterSets
+const ReasonClusterSetMismatch = "ClusterSetMismatch"
+
+// ReasonS3SecretPropagationFailed is set when the DRPolicy failed to propagate its s3 secrets the maximum number of
+// attempts
+const ReasonS3SecretPropagationFailed = "S3SecretPropagationFailed"
+
 //nolint:lll
 //+kubebuilder:rbac:groups=ramendr.openshift.io,resources=drpolicies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=ramendr.openshift.io,resources=drpolicies/status,verbs=get;update;patch
@@ -84,13 +111,19 @@ func (r *DRPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, client.IgnoreNotFound(fmt.Errorf("get: %w", err))
 	}
 
-	u := &drpolicyUpdater{ctx, drpolicy, r.Client, log}
+	u := &drpolicyUpdater{ctx, drpolicy, r.Client, log, r.eventRecorder, r.notifier, nil}
+
+	if u.validatedTransitions(metav1.ConditionTrue) {
+		r.validationStartTimes.LoadOrStore(drpolicy.UID, time.Now())
+	}
 
 	_, ramenConfig, err := ConfigMapGet(ctx, r.APIReader)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("config map get: %w", u.validatedSetFalse("ConfigMapGetFailed", err))
 	}
 
+	u.ramenConfig = ramenConfig
+
 	if err := util.CreateRamenOpsNamespace(ctx, r.Client, ramenConfig); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create RamenOpsNamespace: %w",
 			u.validatedSetFalse("NamespaceCreateFailed", err))
@@ -106,13 +139,21 @@ func (r *DRPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	// DRPolicy is marked for deletion
 	if util.ResourceIsDeleted(drpolicy) &&
 		controllerutil.ContainsFinalizer(drpolicy, drPolicyFinalizerName) {
+		r.validationStartTimes.Delete(drpolicy.UID)
+		r.secretPropagationAttempts.Delete(drpolicy.UID)
+
 		return ctrl.Result{}, u.deleteDRPolicy(drclusters, secretsUtil, ramenConfig)
 	}
 
 	log.Info("create/update")
 
-	reason, err := validateDRPolicy(ctx, drpolicy, drclusters, r.APIReader)
+	reason, err := validateDRPolicy(ctx, drpolicy, drclusters, r.APIReader, ramenConfig)
 	if err != nil {
+		if reason == ReasonDRPolicyConflict {
+			util.ReportIfNotPresent(r.eventRecorder, drpolicy, corev1.EventTypeWarning,
+				util.EventReasonDRPolicyConflict, err.Error())
+		}
+
 		statusErr := u.validatedSetFalse(reason, err)
 		if !errors.Is(statusErr, err) || reason != ReasonDRClusterNotFound {
 			return ctrl.Result{}, fmt.Errorf("validate: %w", statusErr)
@@ -128,30 +169,182 @@ func (r *DRPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, fmt.Errorf("finalizer add update: %w", u.validatedSetFalse("FinalizerAddFailed", err))
 	}
 
-	if err := u.validatedSetTrue("Succeeded", "drpolicy validated"); err != nil {
+	validatedMessage := "drpolicy validated"
+	if drpolicy.Spec.ReportOnly {
+		validatedMessage = "drpolicy validated in report-only mode"
+	}
+
+	if err := u.validatedSetTrue("Succeeded", validatedMessage); err != nil {
 		return ctrl.Result{}, fmt.Errorf("unable to set drpolicy validation: %w", err)
 	}
 
+	if drpolicy.Spec.ReportOnly {
+		// Validation results are recorded, but the policy is not activated
+		result, err := r.statusReconcile(u, drclusters, ramenConfig)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("unable to update drpolicy status: %w", err)
+		}
+
+		return result, nil
+	}
+
+	r.observeValidationDuration(drpolicy)
+
 	if err := r.initiateDRPolicyMetrics(drpolicy, drclusters); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error in intiating policy metrics: %w", err)
 	}
 
-	return r.reconcile(drpolicy, drclusters, secretsUtil, ramenConfig, log)
+	return r.reconcile(u, drclusters, secretsUtil, ramenConfig, log)
 }
 
-func (r *DRPolicyReconciler) reconcile(drpolicy *ramen.DRPolicy,
+func (r *DRPolicyReconciler) reconcile(u *drpolicyUpdater,
 	drclusters *ramen.DRClusterList,
 	secretsUtil *util.SecretsUtil,
 	ramenConfig *ramen.RamenConfig,
 	log logr.Logger,
 ) (ctrl.Result, error) {
+	drpolicy := u.object
+	maxAttempts := ramenConfig.DrClusterOperator.S3SecretPropagationMaxAttempts
+
+	if r.secretPropagationStopped(drpolicy, maxAttempts) {
+		log.Info("S3 secret propagation failed the maximum number of attempts, not retrying until the DRPolicy," +
+			" its secrets or DRClusters change")
+
+		return ctrl.Result{}, nil
+	}
+
 	if err := propagateS3Secret(drpolicy, drclusters, secretsUtil, ramenConfig, log); err != nil {
+		util.ReportIfNotPresent(r.eventRecorder, drpolicy, corev1.EventTypeWarning,
+			util.EventReasonSecretPropagationFailed, err.Error())
+
+		return r.secretPropagationFailed(u, maxAttempts, err)
+	}
+
+	r.secretPropagationAttempts.Delete(drpolicy.UID)
+
+	if err := u.secretPropagatedConditionRemove(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update drpolicy status: %w", err)
+	}
+
+	result, err := r.statusReconcile(u, drclusters, ramenConfig)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update drpolicy status: %w", err)
+	}
+
+	if ramenConfig.DrClusterOperator.DeploymentAutomationEnabled &&
+		ramenConfig.DrClusterOperator.S3SecretDistributionEnabled {
+		secretNames, _ := drPolicySecretNames(drpolicy, drclusters, ramenConfig)
+
+		util.ReportIfNotPresent(r.eventRecorder, drpolicy, corev1.EventTypeNormal,
+			util.EventReasonSecretPropagated, fmt.Sprintf("propagated secrets %v to drclusters %v",
+				secretNames.List(), util.DRPolicyClusterNames(drpolicy)))
+	}
+
+	return result, nil
+}
+
+// statusReconcile runs the validations of the DRPolicy that are only reported in its status, for both activated and
+// report-only DRPolicies. The versions of the clusters are looked up once for the validations reported from them,
+// and looked up again after a delay if any could not be verified.
+func (r *DRPolicyReconciler) statusReconcile(u *drpolicyUpdater,
+	drclusters *ramen.DRClusterList,
+	ramenConfig *ramen.RamenConfig,
+) (ctrl.Result, error) {
+	result := ctrl.Result{}
+	versions := r.clusterVersions(u, ramenConfig)
+
+	if err := r.versionSkewReconcile(u, ramenConfig.DRPolicyVersionSkewValidation, versions); err != nil {
+		return result, err
+	}
+
+	if err := r.schedulingIntervalSupportedReconcile(u, ramenConfig.VolSyncMinSchedulingIntervals,
+		versions); err != nil {
+		return result, err
+	}
+
+	if err := r.conflictReconcile(u, drclusters, ramenConfig.DRPolicyConflictMode); err != nil {
+		return result, err
+	}
+
+	if err := r.ramenOpsNamespaceReconcile(u, ramenConfig); err != nil {
+		return result, err
+	}
+
+	if versions != nil && len(versions.unverified()) != 0 {
+		delaySetIfLess(&result, versionsUnverifiedRequeueDelay, u.log)
+	}
+
+	return result, clusterPairsReconcile(u, drclusters)
+}
+
+// secretPropagation is the number of failed s3 secret propagation attempts of a DRPolicy generation
+type secretPropagation struct {
+	generation int64
+	attempts   int
+}
+
+// secretPropagationFailed counts the failed s3 secret propagation attempt of the DRPolicy generation, and returns the
+// error to retry it, until maxAttempts, if any, fail. The DRPolicy then reports S3SecretPropagationFailed, and
+// propagation is not retried until the DRPolicy generation, its secrets or DRClusters change.
+func (r *DRPolicyReconciler) secretPropagationFailed(u *drpolicyUpdater, maxAttempts int, err error,
+) (ctrl.Result, error) {
+	attempts := 1
+	if value, ok := r.secretPropagationAttempts.Load(u.object.UID); ok &&
+		value.(secretPropagation).generation == u.object.Generation {
+		attempts += value.(secretPropagation).attempts
+	}
+
+	r.secretPropagationAttempts.Store(u.object.UID, secretPropagation{u.object.Generation, attempts})
+
+	if maxAttempts <= 0 || attempts < maxAttempts {
 		return ctrl.Result{}, fmt.Errorf("drpolicy deploy: %w", err)
 	}
 
+	u.log.Error(err, "S3 secret propagation failed, not retrying", "attempts", attempts)
+
+	if err := u.statusConditionSet(ramen.DRPolicyS3SecretPropagated, metav1.ConditionFalse,
+		ReasonS3SecretPropagationFailed, fmt.Sprintf("failed the maximum number of attempts: %v", err)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to update drpolicy status: %w", err)
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// secretPropagationStopped returns true if the s3 secret propagation of the DRPolicy failed maxAttempts, and reports
+// S3SecretPropagationFailed, for its current generation, and its secrets and DRClusters did not change since
+func (r *DRPolicyReconciler) secretPropagationStopped(drpolicy *ramen.DRPolicy, maxAttempts int) bool {
+	if maxAttempts <= 0 {
+		return false
+	}
+
+	value, ok := r.secretPropagationAttempts.Load(drpolicy.UID)
+	if !ok {
+		return false
+	}
+
+	propagation := value.(secretPropagation)
+	if propagation.generation != drpolicy.Generation || propagation.attempts < maxAttempts {
+		return false
+	}
+
+	condition := meta.FindStatusCondition(drpolicy.Status.Conditions, ramen.DRPolicyS3SecretPropagated)
+
+	return condition != nil && condition.Reason == ReasonS3SecretPropagationFailed &&
+		condition.ObservedGeneration == drpolicy.Generation
+}
+
+// observeValidationDuration records the time from the first reconcile of the DRPolicy to its validation, once per
+// transition to validated
+func (r *DRPolicyReconciler) observeValidationDuration(drpolicy *ramen.DRPolicy) {
+	startTime, ok := r.validationStartTimes.LoadAndDelete(drpolicy.UID)
+	if !ok {
+		return
+	}
+
+	metric := NewDRPolicyValidationDurationMetrics(DRPolicyValidationDurationMetricLabels(drpolicy))
+	metric.DRPolicyValidationDuration.Observe(time.Since(startTime.(time.Time)).Seconds())
+}
+
 func (r *DRPolicyReconciler) initiateDRPolicyMetrics(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClusterList) error {
 	isMetro, _ := dRPolicySupportsMetro(drpolicy, drclusters.Items)
 
@@ -165,25 +358,188 @@ func (r *DRPolicyReconciler) initiateDRPolicyMetrics(drpolicy *ramen.DRPolicy, d
 	return nil
 }
 
-func validateDRPolicy(ctx context.Context,
+// Names of the checks validating a DRPolicy, in the order they are run
+const (
+	DRPolicyCheckDRClustersListed     = "DRClustersListed"
+	DRPolicyCheckDRClustersAvailable  = "DRClustersAvailable"
+	DRPolicyCheckClusterSets          = "ClusterSetsCompatible"
+	DRPolicyCheckNoConflicts          = "NoConflictingDRPolicies"
+	DRPolicyCheckDRPoliciesPerCluster = "DRPoliciesPerCluster"
+	DRPolicyCheckSchedulingInterval   = "SchedulingIntervalAllowed"
+)
+
+// DRPolicyValidationCheck is the result of one of the checks validating a DRPolicy
+type DRPolicyValidationCheck struct {
+	// Name of the check
+	Name string `json:"name"`
+	// Passed is true if the DRPolicy passed the check
+	Passed bool `json:"passed"`
+	// Reason of a failed check, as set on the DRPolicy validated condition
+	Reason string `json:"reason,omitempty"`
+	// Message of a failed check
+	Message string `json:"message,omitempty"`
+	// Warning is true if the failure of the check is only reported, and does not fail the validation of the DRPolicy
+	Warning bool `json:"warning,omitempty"`
+
+	err error
+}
+
+// ValidateDRPolicyReport runs each of the checks validating a DRPolicy and reports their results, without updating
+// its status, so that both the DRPolicy reconciler and tools like ramenctl can consume them
+func ValidateDRPolicyReport(ctx context.Context,
+	apiReader client.Reader,
 	drpolicy *ramen.DRPolicy,
 	drclusters *ramen.DRClusterList,
+	ramenConfig *ramen.RamenConfig,
+) []DRPolicyValidationCheck {
+	drpolicies, err := util.GetAllDRPolicies(ctx, apiReader)
+	if err != nil {
+		report := drPolicyClusterChecks(ctx, apiReader, drpolicy, drclusters, ramenConfig)
+
+		err = fmt.Errorf("validate managed cluster in drpolicy %v failed: %w", drpolicy.Name, err)
+		report.add(DRPolicyCheckNoConflicts, ReasonValidationFailed, err)
+		report.add(DRPolicyCheckDRPoliciesPerCluster, ReasonValidationFailed, err)
+
+		return report
+	}
+
+	return drPolicyChecks(ctx, apiReader, drpolicy, drclusters, drpolicies, ramenConfig)
+}
+
+// DryRunValidateDRPolicy runs each of the checks validating a proposed DRPolicy against the given DRClusters and
+// DRPolicies, and reports their results as ValidateDRPolicyReport does, without creating or updating the DRPolicy, e.g.
+// for a ramenctl validate command or a webhook warning. A proposed DRPolicy that is not created yet is validated as
+// created after the given DRPolicies.
+func DryRunValidateDRPolicy(ctx context.Context,
 	apiReader client.Reader,
-) (string, error) {
+	drpolicy *ramen.DRPolicy,
+	drclusters *ramen.DRClusterList,
+	drpolicies ramen.DRPolicyList,
+	ramenConfig *ramen.RamenConfig,
+) []DRPolicyValidationCheck {
+	proposed := drpolicy.DeepCopy()
+	if proposed.CreationTimestamp.IsZero() {
+		proposed.CreationTimestamp = metav1.Now()
+	}
+
+	return drPolicyChecks(ctx, apiReader, proposed, drclusters, drpolicies, ramenConfig)
+}
+
+type drPolicyValidationReport []DRPolicyValidationCheck
+
+func (r *drPolicyValidationReport) add(name, reason string, err error) {
+	check := DRPolicyValidationCheck{Name: name, Passed: err == nil}
+	if err != nil {
+		check.Reason = reason
+		check.Message = err.Error()
+		check.err = err
+	}
+
+	*r = append(*r, check)
+}
+
+// warn adds the result of a check whose failure does not fail the validation of the DRPolicy
+func (r *drPolicyValidationReport) warn(name, reason string, err error) {
+	r.add(name, reason, err)
+	(*r)[len(*r)-1].Warning = err != nil
+}
+
+// drPolicyChecks runs the checks validating the DRPolicy, against its DRClusters and the other DRPolicies
+func drPolicyChecks(ctx context.Context,
+	apiReader client.Reader,
+	drpolicy *ramen.DRPolicy,
+	drclusters *ramen.DRClusterList,
+	drpolicies ramen.DRPolicyList,
+	ramenConfig *ramen.RamenConfig,
+) drPolicyValidationReport {
+	report := drPolicyClusterChecks(ctx, apiReader, drpolicy, drclusters, ramenConfig)
+
+	err := hasConflictingDRPolicy(drpolicy, drclusters, drpolicies)
+	if err != nil {
+		err = fmt.Errorf("validate managed cluster in drpolicy failed: %w", err)
+	}
+
+	if ramenConfig.DRPolicyConflictMode == ramen.DRPolicyConflictModeWarn {
+		report.warn(DRPolicyCheckNoConflicts, ReasonDRPolicyConflict, err)
+	} else {
+		report.add(DRPolicyCheckNoConflicts, ReasonDRPolicyConflict, err)
+	}
+
+	if err = exceedsDRPoliciesPerCluster(drpolicy, drpolicies, ramenConfig.MaxDRPoliciesPerCluster); err != nil {
+		err = fmt.Errorf("validate managed cluster in drpolicy failed: %w", err)
+	}
+
+	report.add(DRPolicyCheckDRPoliciesPerCluster, ReasonDRPolicyLimitExceeded, err)
+
+	return report
+}
+
+// drPolicyClusterChecks runs the checks validating the DRPolicy that do not depend on the other DRPolicies
+func drPolicyClusterChecks(ctx context.Context,
+	apiReader client.Reader,
+	drpolicy *ramen.DRPolicy,
+	drclusters *ramen.DRClusterList,
+	ramenConfig *ramen.RamenConfig,
+) drPolicyValidationReport {
+	report := drPolicyValidationReport{}
+
 	// TODO: Ensure DRClusters exist and are validated? Also ensure they are not in a deleted state!?
 	// If new DRPolicy and clusters are deleted, then fail reconciliation?
+	var err error
 	if len(drpolicy.Spec.DRClusters) == 0 {
-		return ReasonValidationFailed, fmt.Errorf("missing DRClusters list in policy")
+		err = fmt.Errorf("missing DRClusters list in policy")
 	}
 
+	report.add(DRPolicyCheckDRClustersListed, ReasonValidationFailed, err)
+
+	report.add(DRPolicyCheckSchedulingInterval, ReasonValidationFailed,
+		schedulingIntervalAllowed(drpolicy, ramenConfig.MinSchedulingInterval))
+
 	reason, err := ensureDRClustersAvailable(drpolicy, drclusters)
+	report.add(DRPolicyCheckDRClustersAvailable, reason, err)
+
+	reason, err = clusterSetsCompatible(ctx, apiReader, drpolicy, ramenConfig.DRPolicyClusterSetValidation)
+	report.add(DRPolicyCheckClusterSets, reason, err)
+
+	return report
+}
+
+// schedulingIntervalAllowed returns an error if the DRPolicy requests a scheduling interval shorter than the
+// minimum allowed
+func schedulingIntervalAllowed(drpolicy *ramen.DRPolicy, minSchedulingInterval string) error {
+	if minSchedulingInterval == "" || drpolicy.Spec.SchedulingInterval == "" {
+		return nil
+	}
+
+	minSeconds, err := util.SchedulingIntervalSeconds(minSchedulingInterval)
 	if err != nil {
-		return reason, err
+		return fmt.Errorf("invalid minimum scheduling interval %s in ramen config: %w", minSchedulingInterval, err)
 	}
 
-	err = validatePolicyConflicts(ctx, apiReader, drpolicy, drclusters)
+	seconds, err := util.GetSecondsFromSchedulingInterval(drpolicy)
 	if err != nil {
-		return ReasonValidationFailed, err
+		return fmt.Errorf("invalid scheduling interval %s: %w", drpolicy.Spec.SchedulingInterval, err)
+	}
+
+	if seconds < minSeconds {
+		return fmt.Errorf("scheduling interval %s is shorter than the minimum allowed %s",
+			drpolicy.Spec.SchedulingInterval, minSchedulingInterval)
+	}
+
+	return nil
+}
+
+// validateDRPolicy returns the reason and error of the first failed check validating the DRPolicy
+func validateDRPolicy(ctx context.Context,
+	drpolicy *ramen.DRPolicy,
+	drclusters *ramen.DRClusterList,
+	apiReader client.Reader,
+	ramenConfig *ramen.RamenConfig,
+) (string, error) {
+	for _, check := range ValidateDRPolicyReport(ctx, apiReader, drpolicy, drclusters, ramenConfig) {
+		if !check.Passed && !check.Warning {
+			return check.Reason, check.err
+		}
 	}
 
 	return "", nil
@@ -208,6 +564,7 @@ func (r *DRPolicyReconciler) setDRPolicyMetrics(drPolicy *ramen.DRPolicy) error
 func ensureDRClustersAvailable(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClusterList) (string, error) {
 	found := 0
 	validated := 0
+	notValidated := []string{}
 
 	for _, specCluster := range drpolicy.Spec.DRClusters {
 		for _, cluster := range drclusters.Items {
@@ -217,7 +574,11 @@ func ensureDRClustersAvailable(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClu
 				condition := findCondition(cluster.Status.Conditions, ramen.DRClusterValidated)
 				if condition != nil && condition.Status == metav1.ConditionTrue {
 					validated++
+
+					continue
 				}
+
+				notValidated = append(notValidated, drClusterNotValidatedReason(cluster.Name, condition))
 			}
 		}
 	}
@@ -228,31 +589,98 @@ func ensureDRClustersAvailable(drpolicy *ramen.DRPolicy, drclusters *ramen.DRClu
 	}
 
 	if validated == 0 {
-		return ReasonDRClustersUnavailable, fmt.Errorf("none of the DRClusters are validated (%v)",
-			drpolicy.Spec.DRClusters)
+		return ReasonDRClustersUnavailable, fmt.Errorf("none of the DRClusters are validated (%s)",
+			strings.Join(notValidated, "; "))
 	}
 
 	return "", nil
 }
 
-func validatePolicyConflicts(ctx context.Context,
-	apiReader client.Reader,
-	drpolicy *ramen.DRPolicy,
-	drclusters *ramen.DRClusterList,
-) error {
-	drpolicies, err := util.GetAllDRPolicies(ctx, apiReader)
-	if err != nil {
-		return fmt.Errorf("validate managed cluster in drpolicy %v failed: %w", drpolicy.Name, err)
+// drClusterNotValidatedReason describes why a DRCluster is not validated, from its validated condition
+func drClusterNotValidatedReason(clusterName string, condition *metav1.Condition) string {
+	if condition == nil {
+		return fmt.Sprintf("%s: validation pending", clusterName)
 	}
 
-	err = hasConflictingDRPolicy(drpolicy, drclusters, drpolicies)
-	if err != nil {
-		return fmt.Errorf("validate managed cluster in drpolicy failed: %w", err)
+	return fmt.Sprintf("%s: %s: %s", clusterName, condition.Reason, condition.Message)
+}
+
+// clusterSetsCompatible fails, if ManagedClusterSet validation is enabled, unless the clusters of the DRPolicy all
+// belong to the same ManagedClusterSet, or each of them belongs to one of the allowed ManagedClusterSets. The
+// ManagedClusterSet of a cluster is read from the label on its ManagedCluster, which has the name of the DRCluster.
+func clusterSetsCompatible(ctx context.Context, apiReader client.Reader, drpolicy *ramen.DRPolicy,
+	validation ramen.ClusterSetValidation,
+) (string, error) {
+	if !validation.Enabled {
+		return "", nil
+	}
+
+	clusterSets := sets.NewString()
+	clusterSetOf := make([]string, 0, len(drpolicy.Spec.DRClusters))
+
+	for _, clusterName := range drpolicy.Spec.DRClusters {
+		managedCluster := &ocmclv1.ManagedCluster{}
+		if err := apiReader.Get(ctx, types.NamespacedName{Name: clusterName}, managedCluster); err != nil {
+			return ReasonValidationFailed, fmt.Errorf("failed to get ManagedCluster %s: %w", clusterName, err)
+		}
+
+		clusterSet := managedCluster.GetLabels()[clrapiv1beta2.ClusterSetLabel]
+		clusterSets.Insert(clusterSet)
+		clusterSetOf = append(clusterSetOf, fmt.Sprintf("%s: %q", clusterName, clusterSet))
+	}
+
+	if clusterSets.Len() == 1 && !clusterSets.Has("") {
+		return "", nil
+	}
+
+	if sets.NewString(validation.AllowedClusterSets...).IsSuperset(clusterSets) {
+		return "", nil
+	}
+
+	return ReasonClusterSetMismatch, fmt.Errorf("DRClusters do not belong to the same or allowed ManagedClusterSets"+
+		" (%s)", strings.Join(clusterSetOf, "; "))
+}
+
+// exceedsDRPoliciesPerCluster fails if, counting only the active drpolicies created before it, the drpolicy
+// would exceed the maximum number of drpolicies of any of its clusters. A max of zero is unlimited.
+func exceedsDRPoliciesPerCluster(match *ramen.DRPolicy, list ramen.DRPolicyList, maxPolicies int) error {
+	if maxPolicies <= 0 {
+		return nil
+	}
+
+	for _, clusterName := range util.DRPolicyClusterNames(match) {
+		count := 1
+
+		for i := range list.Items {
+			drp := &list.Items[i]
+
+			if drp.ObjectMeta.Name == match.ObjectMeta.Name || drp.Spec.ReportOnly || !drPolicyCreatedBefore(drp, match) {
+				continue
+			}
+
+			if sets.NewString(util.DRPolicyClusterNames(drp)...).Has(clusterName) {
+				count++
+			}
+		}
+
+		if count > maxPolicies {
+			return fmt.Errorf("drpolicy: %v exceeds the maximum of %d drpolicies for cluster %v",
+				match.Name, maxPolicies, clusterName)
+		}
 	}
 
 	return nil
 }
 
+// drPolicyCreatedBefore orders drpolicies by creation time, and by name for those created in the same second
+func drPolicyCreatedBefore(d1, d2 *ramen.DRPolicy) bool {
+	if !d1.CreationTimestamp.Equal(&d2.CreationTimestamp) {
+		return d1.CreationTimestamp.Before(&d2.CreationTimestamp)
+	}
+
+	return d1.Name < d2.Name
+}
+
 // If two drpolicies have common managed cluster(s) and at least one of them is
 // a metro supported drpolicy, then fail.
 func hasConflictingDRPolicy(match *ramen.DRPolicy, drclusters *ramen.DRClusterList, list ramen.DRPolicyList) error {
@@ -271,7 +699,8 @@ func hasConflictingDRPolicy(match *ramen.DRPolicy, drclusters *ramen.DRClusterLi
 	for i := range list.Items {
 		drp := &list.Items[i]
 
-		if drp.ObjectMeta.Name == match.ObjectMeta.Name {
+		// A report-only policy is not active, so it cannot conflict with other policies
+		if drp.ObjectMeta.Name == match.ObjectMeta.Name || drp.Spec.ReportOnly {
 			continue
 		}
 
@@ -318,10 +747,14 @@ func haveOverlappingMetroZones(d1 *ramen.DRPolicy, d2 *ramen.DRPolicy, drcluster
 }
 
 type drpolicyUpdater struct {
-	ctx    context.Context
-	object *ramen.DRPolicy
-	client client.Client
-	log    logr.Logger
+	ctx           context.Context
+	object        *ramen.DRPolicy
+	client        client.Client
+	log           logr.Logger
+	eventRecorder *util.EventReporter
+	notifier      *drPolicyNotifier
+	// ramenConfig configures the notification of validation transitions, none are sent until it is set
+	ramenConfig *ramen.RamenConfig
 }
 
 func (u *drpolicyUpdater) deleteDRPolicy(drclusters *ramen.DRClusterList,
@@ -358,21 +791,69 @@ func (u *drpolicyUpdater) deleteDRPolicy(drclusters *ramen.DRClusterList,
 		DeleteDRPolicySyncIntervalMetrics(metricLabels)
 	}
 
+	DeleteDRPolicyValidationDurationMetrics(DRPolicyValidationDurationMetricLabels(u.object))
+
 	return nil
 }
 
 func (u *drpolicyUpdater) validatedSetTrue(reason, message string) error {
-	return u.statusConditionSet(ramen.DRPolicyValidated, metav1.ConditionTrue, reason, message)
+	transitioned := u.validatedTransitions(metav1.ConditionTrue)
+
+	if err := u.statusConditionSet(ramen.DRPolicyValidated, metav1.ConditionTrue, reason, message); err != nil {
+		return err
+	}
+
+	if transitioned {
+		util.ReportIfNotPresent(u.eventRecorder, u.object, corev1.EventTypeNormal,
+			util.EventReasonDRPolicyValidated, message)
+		u.notifyValidation(true, reason, message)
+	}
+
+	return nil
 }
 
 func (u *drpolicyUpdater) validatedSetFalse(reason string, err error) error {
+	transitioned := u.validatedTransitions(metav1.ConditionFalse)
+
 	if err1 := u.statusConditionSet(ramen.DRPolicyValidated, metav1.ConditionFalse, reason, err.Error()); err1 != nil {
 		return err1
 	}
 
+	if transitioned {
+		util.ReportIfNotPresent(u.eventRecorder, u.object, corev1.EventTypeWarning,
+			util.EventReasonDRPolicyValidationFailed, fmt.Sprintf("%s: %v", reason, err))
+		u.notifyValidation(false, reason, err.Error())
+	}
+
 	return err
 }
 
+func (u *drpolicyUpdater) notifyValidation(validated bool, reason, message string) {
+	u.notifier.notify(u.ramenConfig, DRPolicyValidationNotification{
+		DRPolicy:  u.object.GetName(),
+		Validated: validated,
+		Reason:    reason,
+		Message:   message,
+		Time:      time.Now(),
+	})
+}
+
+// validatedTransitions returns true if setting the validated condition to status changes its status
+func (u *drpolicyUpdater) validatedTransitions(status metav1.ConditionStatus) bool {
+	condition := findCondition(u.object.Status.Conditions, ramen.DRPolicyValidated)
+
+	return condition == nil || condition.Status != status
+}
+
+// secretPropagatedConditionRemove removes the condition reporting that s3 secret propagation failed, if present
+func (u *drpolicyUpdater) secretPropagatedConditionRemove() error {
+	if !meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRPolicyS3SecretPropagated) {
+		return nil
+	}
+
+	return u.statusUpdate()
+}
+
 func (u *drpolicyUpdater) statusConditionSet(conditionType string,
 	status metav1.ConditionStatus,
 	reason, message string,
@@ -408,6 +889,9 @@ func (u *drpolicyUpdater) finalizerRemove() error {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DRPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.eventRecorder = util.NewEventReporter(mgr.GetEventRecorderFor("controller_DRPolicy"))
+	r.notifier = newDRPolicyNotifier(r.Log.WithName("notifier"))
+
 	controller := ctrl.NewControllerManagedBy(mgr)
 	if r.RateLimiter != nil {
 		controller.WithOptions(ctrlcontroller.Options{
@@ -477,6 +961,9 @@ func (r *DRPolicyReconciler) secretMapFunc(ctx context.Context, secret client.Ob
 	requests := make([]reconcile.Request, len(drpolicies.Items))
 	for i, drpolicy := range drpolicies.Items {
 		requests[i].Name = drpolicy.GetName()
+
+		// Retry the s3 secret propagation with the changed secret
+		r.secretPropagationAttempts.Delete(drpolicy.UID)
 	}
 
 	return requests
@@ -493,6 +980,9 @@ func (r *DRPolicyReconciler) drClusterMapFunc(ctx context.Context, drcluster cli
 	for idx := range drpolicies.Items {
 		drpolicy := &drpolicies.Items[idx]
 		if util.DrpolicyContainsDrcluster(drpolicy, drcluster.GetName()) {
+			// Retry the s3 secret propagation with the changed DRCluster
+			r.secretPropagationAttempts.Delete(drpolicy.UID)
+
 			add := reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name: drpolicy.GetName(),
@@ -5,24 +5,63 @@ package controllers_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegaTypes "github.com/onsi/gomega/types"
+	ocmclv1 "github.com/open-cluster-management/api/cluster/v1"
+	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	ramencontrollers "github.com/ramendr/ramen/controllers"
 	"github.com/ramendr/ramen/controllers/util"
+	"github.com/ramendr/ramen/controllers/volsync"
 	plrv1 "github.com/stolostron/multicloud-operators-placementrule/pkg/apis/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	validationErrors "k8s.io/kube-openapi/pkg/validation/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+// fakeVolSyncVersions holds the VolSync version FakeMCVGetter reports for each managed cluster, or the error it
+// fails to look it up with, by cluster name
+var fakeVolSyncVersions sync.Map
+
+// fakeMCVNotProcessed is the error of a ManagedClusterView not processed yet
+var fakeMCVNotProcessed = errors.NewServiceUnavailable("ManagedClusterView is not ready")
+
+func (f FakeMCVGetter) GetCRDFromManagedCluster(crdName, managedCluster string,
+	annotations map[string]string,
+) (*apiextensionsv1.CustomResourceDefinition, error) {
+	volSyncVersion, ok := fakeVolSyncVersions.Load(managedCluster)
+	if !ok {
+		return nil, errors.NewNotFound(apiextensionsv1.Resource("customresourcedefinitions"), crdName)
+	}
+
+	if err, ok := volSyncVersion.(error); ok {
+		return nil, err
+	}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   crdName,
+			Labels: map[string]string{volsync.VolSyncVersionLabel: volSyncVersion.(string)},
+		},
+	}, nil
+}
+
 var _ = Describe("DRPolicyController", func() {
 	validatedConditionExpect := func(drpolicy *ramen.DRPolicy, status metav1.ConditionStatus,
 		messageMatcher gomegaTypes.GomegaMatcher,
@@ -55,6 +94,43 @@ var _ = Describe("DRPolicyController", func() {
 			interval,
 		).Should(Succeed())
 	}
+	eventExpect := func(drpolicy *ramen.DRPolicy, eventType, reason string) {
+		Eventually(func() []corev1.Event {
+			events := &corev1.EventList{}
+			Expect(apiReader.List(context.TODO(), events)).To(Succeed())
+
+			matched := []corev1.Event{}
+
+			for _, event := range events.Items {
+				if event.InvolvedObject.Kind == "DRPolicy" && event.InvolvedObject.Name == drpolicy.Name &&
+					event.InvolvedObject.UID == drpolicy.UID && event.Type == eventType && event.Reason == reason {
+					matched = append(matched, event)
+				}
+			}
+
+			return matched
+		}, timeout, interval).ShouldNot(BeEmpty())
+	}
+	validationDurationSampleCount := func(drpolicy *ramen.DRPolicy) uint64 {
+		families, err := metrics.Registry.Gather()
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, family := range families {
+			if family.GetName() != "ramen_"+ramencontrollers.DRPolicyValidationDurationSeconds {
+				continue
+			}
+
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if label.GetName() == ramencontrollers.Policyname && label.GetValue() == drpolicy.Name {
+						return metric.GetHistogram().GetSampleCount()
+					}
+				}
+			}
+		}
+
+		return 0
+	}
 	drpolicyCreate := func(drpolicy *ramen.DRPolicy) {
 		Expect(k8sClient.Create(context.TODO(), drpolicy)).To(Succeed())
 	}
@@ -242,6 +318,11 @@ var _ = Describe("DRPolicyController", func() {
 			drpolicyCreate(drpolicy)
 			validatedConditionExpect(drpolicy, metav1.ConditionTrue, Ignore())
 			vaildateSecretDistribution(drpolicies[0:1])
+			eventExpect(drpolicy, corev1.EventTypeNormal, util.EventReasonDRPolicyValidated)
+			eventExpect(drpolicy, corev1.EventTypeNormal, util.EventReasonSecretPropagated)
+			Eventually(func() uint64 {
+				return validationDurationSampleCount(drpolicy)
+			}, timeout, interval).Should(BeEquivalentTo(1))
 		})
 	})
 	When("a 2nd drpolicy is created specifying some clusters in a 1st drpolicy and some not", func() {
@@ -302,6 +383,567 @@ var _ = Describe("DRPolicyController", func() {
 			Expect(k8sClient.Create(context.TODO(), drp)).To(MatchError(err(drp.Spec.SchedulingInterval)))
 		})
 	})
+	When("a drpolicy is created in report-only mode", func() {
+		It("should be validated without propagating secrets", func() {
+			drp := drpolicy.DeepCopy()
+			drp.Spec.ReportOnly = true
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, ContainSubstring("report-only"))
+			Consistently(func() int {
+				return len(getPlRuleForSecrets())
+			}, "2s", interval).Should(Equal(0))
+			Expect(util.DrpolicyValidated(drp)).To(MatchError(ContainSubstring("report-only")))
+			drpolicyDeleteAndConfirm(drp)
+		})
+		It("should report the results of the validations recorded in the status", func() {
+			ramenConfig.DRPolicyVersionSkewValidation.Enabled = true
+			configMapUpdate()
+			DeferCleanup(func() {
+				ramenConfig.DRPolicyVersionSkewValidation = ramen.VersionSkewValidation{}
+				configMapUpdate()
+				fakeVolSyncVersions.Delete("drp-cluster0")
+				fakeVolSyncVersions.Delete("drp-cluster1")
+			})
+			fakeVolSyncVersions.Store("drp-cluster0", "0.7.1")
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drp.Spec.ReportOnly = true
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, ContainSubstring("report-only"))
+			Eventually(func(g Gomega) {
+				g.Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+				g.Expect(meta.IsStatusConditionTrue(drp.Status.Conditions, ramen.DRPolicyVersionSkew)).To(BeTrue())
+				g.Expect(drp.Status.ClusterPairs).To(Equal([]ramen.ClusterPairReplication{{
+					Clusters:        []string{"drp-cluster0", "drp-cluster1"},
+					ReplicationMode: ramen.ReplicationModeAsync,
+				}}))
+			}, timeout, interval).Should(Succeed())
+			Expect(validationDurationSampleCount(drp)).To(BeNumerically(">", 0))
+			drpolicyDeleteAndConfirm(drp)
+		})
+	})
+	When("a drpolicy validation notification url is configured", func() {
+		It("should post a notification when a drpolicy becomes validated, retrying failed posts", func() {
+			drp := drpolicy.DeepCopy()
+			notifications := make(chan ramencontrollers.DRPolicyValidationNotification, 10)
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				notification := ramencontrollers.DRPolicyValidationNotification{}
+				if err := json.NewDecoder(r.Body).Decode(&notification); err != nil ||
+					notification.DRPolicy != drp.Name {
+					return
+				}
+				if attempts.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+				notifications <- notification
+			}))
+			defer server.Close()
+			ramenConfig.DRPolicyNotification.URL = server.URL
+			configMapUpdate()
+			defer func() {
+				ramenConfig.DRPolicyNotification.URL = ""
+				configMapUpdate()
+			}()
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			Eventually(notifications, "10s", interval).Should(Receive(MatchFields(IgnoreExtras, Fields{
+				"DRPolicy":  Equal(drp.Name),
+				"Validated": BeTrue(),
+			})))
+			drpolicyDeleteAndConfirm(drp)
+		})
+	})
+	When("the number of drpolicies per cluster is capped", func() {
+		It("should validate drpolicies at the cap and reject those over it, naming the cluster", func() {
+			ramenConfig.MaxDRPoliciesPerCluster = 1
+			configMapUpdate()
+			defer func() {
+				ramenConfig.MaxDRPoliciesPerCluster = 0
+				configMapUpdate()
+			}()
+			drp0 := &ramen.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: drpolicies[0].Name},
+				Spec:       *drpolicies[0].Spec.DeepCopy(),
+			}
+			drp1 := &ramen.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: drpolicies[1].Name},
+				Spec:       *drpolicies[1].Spec.DeepCopy(),
+			}
+			drpolicyCreate(drp0)
+			validatedConditionExpect(drp0, metav1.ConditionTrue, Ignore())
+			drpolicyCreate(drp1)
+			validatedConditionExpect(drp1, metav1.ConditionFalse, ContainSubstring("cluster drp-cluster1"))
+			drpolicyDeleteAndConfirm(drp1)
+			drpolicyDeleteAndConfirm(drp0)
+			vaildateSecretDistribution(nil)
+		})
+	})
+	When("a minimum scheduling interval is configured", func() {
+		It("should not validate a drpolicy requesting a shorter scheduling interval", func() {
+			ramenConfig.MinSchedulingInterval = "5m"
+			configMapUpdate()
+			defer func() {
+				ramenConfig.MinSchedulingInterval = ""
+				configMapUpdate()
+			}()
+			drp := drpolicy.DeepCopy()
+			drp.Spec.SchedulingInterval = "1m"
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionFalse, ContainSubstring("minimum allowed 5m"))
+			drpolicyDeleteAndConfirm(drp)
+		})
+	})
+	When("a drpolicy validation report is requested", func() {
+		checks := func(drp *ramen.DRPolicy) []ramencontrollers.DRPolicyValidationCheck {
+			drclusters := &ramen.DRClusterList{}
+			Expect(apiReader.List(context.TODO(), drclusters)).To(Succeed())
+
+			return ramencontrollers.ValidateDRPolicyReport(context.TODO(), apiReader, drp, drclusters, ramenConfig)
+		}
+		check := func(name string, passed bool, reason string) gomegaTypes.GomegaMatcher {
+			return MatchFields(IgnoreExtras, Fields{
+				"Name":   Equal(name),
+				"Passed": Equal(passed),
+				"Reason": Equal(reason),
+			})
+		}
+
+		It("should report each check as passed for a valid drpolicy", func() {
+			Expect(checks(drpolicy.DeepCopy())).To(ConsistOf(
+				check(ramencontrollers.DRPolicyCheckDRClustersListed, true, ""),
+				check(ramencontrollers.DRPolicyCheckSchedulingInterval, true, ""),
+				check(ramencontrollers.DRPolicyCheckDRClustersAvailable, true, ""),
+				check(ramencontrollers.DRPolicyCheckClusterSets, true, ""),
+				check(ramencontrollers.DRPolicyCheckNoConflicts, true, ""),
+				check(ramencontrollers.DRPolicyCheckDRPoliciesPerCluster, true, ""),
+			))
+		})
+		It("should report the failed check for a drpolicy specifying a missing cluster", func() {
+			drp := drpolicy.DeepCopy()
+			drp.Spec.DRClusters = []string{"missing", "drp-cluster0"}
+			Expect(checks(drp)).To(ConsistOf(
+				check(ramencontrollers.DRPolicyCheckDRClustersListed, true, ""),
+				check(ramencontrollers.DRPolicyCheckSchedulingInterval, true, ""),
+				check(ramencontrollers.DRPolicyCheckDRClustersAvailable, false, ramencontrollers.ReasonDRClusterNotFound),
+				check(ramencontrollers.DRPolicyCheckClusterSets, true, ""),
+				check(ramencontrollers.DRPolicyCheckNoConflicts, true, ""),
+				check(ramencontrollers.DRPolicyCheckDRPoliciesPerCluster, true, ""),
+			))
+		})
+		It("should report the failed check for a drpolicy requesting a disallowed scheduling interval", func() {
+			ramenConfig.MinSchedulingInterval = "5m"
+			defer func() { ramenConfig.MinSchedulingInterval = "" }()
+			drp := drpolicy.DeepCopy()
+			drp.Spec.SchedulingInterval = "1m"
+			Expect(checks(drp)).To(ContainElement(
+				check(ramencontrollers.DRPolicyCheckSchedulingInterval, false, ramencontrollers.ReasonValidationFailed),
+			))
+			drp.Spec.SchedulingInterval = "1h"
+			Expect(checks(drp)).To(ContainElement(
+				check(ramencontrollers.DRPolicyCheckSchedulingInterval, true, ""),
+			))
+		})
+		It("should report the reasons of DRClusters that are not validated", func() {
+			drp := drpolicy.DeepCopy()
+			drp.Spec.DRClusters = []string{"s3-invalid", "fencing-pending", "pending"}
+			notValidated := func(name, reason, message string) ramen.DRCluster {
+				drcluster := ramen.DRCluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				if reason != "" {
+					drcluster.Status.Conditions = []metav1.Condition{{
+						Type:    ramen.DRClusterValidated,
+						Status:  metav1.ConditionFalse,
+						Reason:  reason,
+						Message: message,
+					}}
+				}
+
+				return drcluster
+			}
+			drclusters := &ramen.DRClusterList{Items: []ramen.DRCluster{
+				notValidated("s3-invalid", "s3ConnectionFailed", "s3 profile invalid"),
+				notValidated("fencing-pending", "Fencing", "cluster fencing in progress"),
+				notValidated("pending", "", ""),
+			}}
+			report := ramencontrollers.ValidateDRPolicyReport(context.TODO(), apiReader, drp, drclusters, ramenConfig)
+			Expect(report).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Name":   Equal(ramencontrollers.DRPolicyCheckDRClustersAvailable),
+				"Passed": BeFalse(),
+				"Reason": Equal(ramencontrollers.ReasonDRClustersUnavailable),
+				"Message": SatisfyAll(
+					ContainSubstring("s3-invalid: s3ConnectionFailed: s3 profile invalid"),
+					ContainSubstring("fencing-pending: Fencing: cluster fencing in progress"),
+					ContainSubstring("pending: validation pending"),
+				),
+			})))
+		})
+		When("managedclusterset validation is enabled", func() {
+			clusterSetLabel := "cluster.open-cluster-management.io/clusterset"
+			managedClusterCreate := func(name, clusterSet string) {
+				managedCluster := &ocmclv1.ManagedCluster{ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{clusterSetLabel: clusterSet},
+				}}
+				Expect(k8sClient.Create(context.TODO(), managedCluster)).To(Succeed())
+				DeferCleanup(k8sClient.Delete, context.TODO(), managedCluster)
+			}
+			BeforeEach(func() {
+				ramenConfig.DRPolicyClusterSetValidation.Enabled = true
+				DeferCleanup(func() { ramenConfig.DRPolicyClusterSetValidation = ramen.ClusterSetValidation{} })
+				managedClusterCreate("clusterset-east1", "east")
+				managedClusterCreate("clusterset-east2", "east")
+				managedClusterCreate("clusterset-west1", "west")
+			})
+			It("should pass a drpolicy referencing clusters of the same managedclusterset", func() {
+				drp := drpolicy.DeepCopy()
+				drp.Spec.DRClusters = []string{"clusterset-east1", "clusterset-east2"}
+				Expect(checks(drp)).To(ContainElement(check(ramencontrollers.DRPolicyCheckClusterSets, true, "")))
+			})
+			It("should fail a drpolicy referencing clusters of different managedclustersets", func() {
+				drp := drpolicy.DeepCopy()
+				drp.Spec.DRClusters = []string{"clusterset-east1", "clusterset-west1"}
+				Expect(checks(drp)).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Name":   Equal(ramencontrollers.DRPolicyCheckClusterSets),
+					"Passed": BeFalse(),
+					"Reason": Equal(ramencontrollers.ReasonClusterSetMismatch),
+					"Message": SatisfyAll(
+						ContainSubstring(`clusterset-east1: "east"`),
+						ContainSubstring(`clusterset-west1: "west"`),
+					),
+				})))
+			})
+			It("should pass a drpolicy referencing clusters of different allowed managedclustersets", func() {
+				ramenConfig.DRPolicyClusterSetValidation.AllowedClusterSets = []string{"east", "west"}
+				drp := drpolicy.DeepCopy()
+				drp.Spec.DRClusters = []string{"clusterset-east1", "clusterset-west1"}
+				Expect(checks(drp)).To(ContainElement(check(ramencontrollers.DRPolicyCheckClusterSets, true, "")))
+			})
+		})
+		It("should report the failed checks for a drpolicy specifying no clusters", func() {
+			drp := drpolicy.DeepCopy()
+			drp.Spec.DRClusters = nil
+			Expect(checks(drp)).To(ContainElements(
+				check(ramencontrollers.DRPolicyCheckDRClustersListed, false, ramencontrollers.ReasonValidationFailed),
+				check(ramencontrollers.DRPolicyCheckDRClustersAvailable, false,
+					ramencontrollers.ReasonDRClustersUnavailable),
+			))
+		})
+	})
+	When("a proposed drpolicy is validated with a dry run", func() {
+		dryRun := func(drp *ramen.DRPolicy, existing ...ramen.DRPolicy) []ramencontrollers.DRPolicyValidationCheck {
+			drclusters := &ramen.DRClusterList{}
+			Expect(apiReader.List(context.TODO(), drclusters)).To(Succeed())
+
+			return ramencontrollers.DryRunValidateDRPolicy(context.TODO(), apiReader, drp, drclusters,
+				ramen.DRPolicyList{Items: existing}, ramenConfig)
+		}
+		proposal := func(clusterNames ...string) *ramen.DRPolicy {
+			return &ramen.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "drpolicy-proposed"},
+				Spec:       ramen.DRPolicySpec{DRClusters: clusterNames, SchedulingInterval: "1h"},
+			}
+		}
+		notCreated := func(drp *ramen.DRPolicy) {
+			Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, &ramen.DRPolicy{})).To(
+				MatchError(ContainSubstring("not found")))
+		}
+
+		It("should pass each check of a clean proposal, without creating it", func() {
+			drp := proposal("drp-cluster0", "drp-cluster1")
+			report := dryRun(drp, drpolicies[1])
+			Expect(report).To(HaveLen(6))
+			Expect(report).To(HaveEach(HaveField("Passed", BeTrue())))
+			notCreated(drp)
+		})
+		It("should fail the conflicts check of a proposal overlapping the metro clusters of a drpolicy", func() {
+			drp := proposal("drp-cluster0", "drp-cluster2")
+			Expect(dryRun(drp, drpolicies[0])).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Name":    Equal(ramencontrollers.DRPolicyCheckNoConflicts),
+				"Passed":  BeFalse(),
+				"Reason":  Equal(ramencontrollers.ReasonDRPolicyConflict),
+				"Message": ContainSubstring(drpolicies[0].Name),
+			})))
+			notCreated(drp)
+		})
+		It("should only warn of the conflicts of a proposal overlapping metro clusters in warn mode", func() {
+			ramenConfig.DRPolicyConflictMode = ramen.DRPolicyConflictModeWarn
+			defer func() { ramenConfig.DRPolicyConflictMode = "" }()
+			drp := proposal("drp-cluster0", "drp-cluster2")
+			Expect(dryRun(drp, drpolicies[0])).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Name":    Equal(ramencontrollers.DRPolicyCheckNoConflicts),
+				"Passed":  BeFalse(),
+				"Warning": BeTrue(),
+				"Reason":  Equal(ramencontrollers.ReasonDRPolicyConflict),
+			})))
+		})
+		It("should count the drpolicies of a cluster as created before the proposal", func() {
+			ramenConfig.MaxDRPoliciesPerCluster = 1
+			defer func() { ramenConfig.MaxDRPoliciesPerCluster = 0 }()
+			drp := proposal("drp-cluster0", "drp-cluster1")
+			Expect(dryRun(drp, drpolicies[1])).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Name":    Equal(ramencontrollers.DRPolicyCheckDRPoliciesPerCluster),
+				"Passed":  BeFalse(),
+				"Reason":  Equal(ramencontrollers.ReasonDRPolicyLimitExceeded),
+				"Message": ContainSubstring("cluster drp-cluster1"),
+			})))
+		})
+	})
+	When("version skew validation is enabled", func() {
+		versionSkewConditionExpect := func(drp *ramen.DRPolicy, status metav1.ConditionStatus,
+			messageMatcher gomegaTypes.GomegaMatcher,
+		) {
+			Eventually(func(g Gomega) {
+				g.Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+				g.Expect(drp.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(ramen.DRPolicyVersionSkew),
+					"Status":  Equal(status),
+					"Message": messageMatcher,
+				})))
+			}, timeout, interval).Should(Succeed())
+		}
+		BeforeEach(func() {
+			ramenConfig.DRPolicyVersionSkewValidation.Enabled = true
+			configMapUpdate()
+			DeferCleanup(func() {
+				ramenConfig.DRPolicyVersionSkewValidation = ramen.VersionSkewValidation{}
+				configMapUpdate()
+				fakeVolSyncVersions.Delete("drp-cluster0")
+				fakeVolSyncVersions.Delete("drp-cluster1")
+			})
+		})
+		It("should report the versions of a drpolicy whose VolSync versions skew too far", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", "0.7.1")
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			versionSkewConditionExpect(drp, metav1.ConditionTrue, SatisfyAll(
+				ContainSubstring("drp-cluster0: 0.7.1"),
+				ContainSubstring("drp-cluster1: 0.10.0"),
+			))
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+		It("should not report a skew for a drpolicy whose VolSync versions are within the allowed skew", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", "0.9.1")
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			versionSkewConditionExpect(drp, metav1.ConditionFalse, Ignore())
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+		It("should report the skew unknown, naming the cluster, until its VolSync version is verified", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", fakeMCVNotProcessed)
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			versionSkewConditionExpect(drp, metav1.ConditionUnknown, SatisfyAll(
+				ContainSubstring("drp-cluster0"),
+				Not(ContainSubstring("drp-cluster1")),
+			))
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+	})
+	When("VolSync minimum scheduling intervals are configured", func() {
+		schedulingIntervalConditionExpect := func(drp *ramen.DRPolicy, status metav1.ConditionStatus,
+			messageMatcher gomegaTypes.GomegaMatcher,
+		) {
+			Eventually(func(g Gomega) {
+				g.Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+				g.Expect(drp.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(ramen.DRPolicySchedulingIntervalSupported),
+					"Status":  Equal(status),
+					"Message": messageMatcher,
+				})))
+			}, timeout, interval).Should(Succeed())
+		}
+		BeforeEach(func() {
+			ramenConfig.VolSyncMinSchedulingIntervals = []ramen.VolSyncMinSchedulingInterval{
+				{Version: "0.7.0", MinSchedulingInterval: "5m"},
+				{Version: "0.9.0", MinSchedulingInterval: "1m"},
+			}
+			configMapUpdate()
+			DeferCleanup(func() {
+				ramenConfig.VolSyncMinSchedulingIntervals = nil
+				configMapUpdate()
+				fakeVolSyncVersions.Delete("drp-cluster0")
+				fakeVolSyncVersions.Delete("drp-cluster1")
+			})
+		})
+		It("should name the cluster whose VolSync version does not support the scheduling interval", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", "0.7.1")
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drp.Spec.SchedulingInterval = "2m"
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			schedulingIntervalConditionExpect(drp, metav1.ConditionFalse, SatisfyAll(
+				ContainSubstring("minimum 5m supported by VolSync 0.7.1 of cluster drp-cluster0"),
+				Not(ContainSubstring("drp-cluster1")),
+			))
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+		It("should report a scheduling interval supported by the VolSync versions of all clusters", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", "0.9.1")
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drp.Spec.SchedulingInterval = "2m"
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			schedulingIntervalConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+		It("should report the support unknown, naming the cluster, until its VolSync version is verified", func() {
+			fakeVolSyncVersions.Store("drp-cluster0", fakeMCVNotProcessed)
+			fakeVolSyncVersions.Store("drp-cluster1", "0.10.0")
+			drp := drpolicy.DeepCopy()
+			drp.Spec.SchedulingInterval = "2m"
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			schedulingIntervalConditionExpect(drp, metav1.ConditionUnknown, SatisfyAll(
+				ContainSubstring("drp-cluster0"),
+				Not(ContainSubstring("drp-cluster1")),
+			))
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+	})
+	When("a drpolicy overlaps the metro clusters of another drpolicy", func() {
+		conflictingCondition := func(drp *ramen.DRPolicy) func() *metav1.Condition {
+			return func() *metav1.Condition {
+				Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+
+				return meta.FindStatusCondition(drp.Status.Conditions, ramen.DRPolicyConflicting)
+			}
+		}
+		var drp, conflicting *ramen.DRPolicy
+		BeforeEach(func() {
+			drp = drpolicy.DeepCopy()
+			conflicting = &ramen.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "drpolicy-conflicting"},
+				Spec:       ramen.DRPolicySpec{DRClusters: []string{"drp-cluster0", "drp-cluster2"}},
+			}
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			DeferCleanup(func() {
+				drpolicyDeleteAndConfirm(conflicting)
+				drpolicyDeleteAndConfirm(drp)
+				vaildateSecretDistribution(nil)
+			})
+		})
+		It("should reject the drpolicy by default", func() {
+			drpolicyCreate(conflicting)
+			validatedConditionExpect(conflicting, metav1.ConditionFalse, ContainSubstring(drp.Name))
+			Consistently(conflictingCondition(conflicting), "1s", interval).Should(BeNil())
+		})
+		It("should validate the drpolicy and report the conflict in warn mode", func() {
+			ramenConfig.DRPolicyConflictMode = ramen.DRPolicyConflictModeWarn
+			configMapUpdate()
+			DeferCleanup(func() {
+				ramenConfig.DRPolicyConflictMode = ""
+				configMapUpdate()
+			})
+			drpolicyCreate(conflicting)
+			validatedConditionExpect(conflicting, metav1.ConditionTrue, Ignore())
+			Eventually(conflictingCondition(conflicting), timeout, interval).Should(And(
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", ramencontrollers.ReasonDRPolicyConflict),
+				HaveField("Message", ContainSubstring(drp.Name)),
+			))
+			eventExpect(conflicting, corev1.EventTypeWarning, util.EventReasonDRPolicyConflict)
+		})
+	})
+	When("a RamenOps namespace is configured", func() {
+		const ramenOpsNamespaceName = "ramen-ops-drpolicy"
+		ramenOpsNamespaceCondition := func(drp *ramen.DRPolicy) func() *metav1.Condition {
+			return func() *metav1.Condition {
+				Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+
+				return meta.FindStatusCondition(drp.Status.Conditions, ramen.DRPolicyRamenOpsNamespaceConsistent)
+			}
+		}
+		// FakeMCVGetter reports a namespace of a managed cluster to exist if its ManifestWork does
+		ramenOpsNamespaceCreate := func(clusterName string) {
+			mw := &ocmworkv1.ManifestWork{ObjectMeta: metav1.ObjectMeta{
+				Name:      util.ManifestWorkName(ramenOpsNamespaceName, ramenOpsNamespaceName, util.MWTypeNS),
+				Namespace: clusterName,
+			}}
+			Expect(k8sClient.Create(context.TODO(), mw)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(context.TODO(), mw))).To(Succeed())
+			})
+		}
+		var drp *ramen.DRPolicy
+		BeforeEach(func() {
+			ramenConfig.RamenOpsNamespace = ramenOpsNamespaceName
+			configMapUpdate()
+			drp = drpolicy.DeepCopy()
+			DeferCleanup(func() {
+				ramenConfig.RamenOpsNamespace = ""
+				configMapUpdate()
+				drpolicyDeleteAndConfirm(drp)
+				vaildateSecretDistribution(nil)
+			})
+		})
+		It("should name the cluster missing the namespace", func() {
+			ramenOpsNamespaceCreate("drp-cluster0")
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			Eventually(ramenOpsNamespaceCondition(drp), timeout, interval).Should(And(
+				HaveField("Status", metav1.ConditionFalse),
+				HaveField("Reason", ramencontrollers.ReasonRamenOpsNamespacePending),
+				HaveField("Message", SatisfyAll(
+					ContainSubstring(ramenOpsNamespaceName),
+					ContainSubstring("drp-cluster1"),
+					Not(ContainSubstring("drp-cluster0")),
+				)),
+			))
+		})
+		It("should report the namespace consistent once it exists on all clusters", func() {
+			ramenOpsNamespaceCreate("drp-cluster0")
+			ramenOpsNamespaceCreate("drp-cluster1")
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			Eventually(ramenOpsNamespaceCondition(drp), timeout, interval).Should(And(
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", ramencontrollers.ReasonRamenOpsNamespaceExists),
+			))
+		})
+	})
+	When("the replication mode of the cluster pairs of a drpolicy is reported", func() {
+		It("should report the async pair of a drpolicy with clusters in different regions", func() {
+			drp := drpolicy.DeepCopy()
+			drpolicyCreate(drp)
+			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			Eventually(func(g Gomega) {
+				g.Expect(apiReader.Get(context.TODO(), types.NamespacedName{Name: drp.Name}, drp)).To(Succeed())
+				g.Expect(drp.Status.ClusterPairs).To(Equal([]ramen.ClusterPairReplication{{
+					Clusters:        []string{"drp-cluster0", "drp-cluster1"},
+					ReplicationMode: ramen.ReplicationModeAsync,
+				}}))
+			}, timeout, interval).Should(Succeed())
+			drpolicyDeleteAndConfirm(drp)
+			vaildateSecretDistribution(nil)
+		})
+		It("should report both the metro and async pairs of a drpolicy with clusters in mixed regions", func() {
+			drp := &ramen.DRPolicy{Spec: ramen.DRPolicySpec{
+				DRClusters: []string{"drp-cluster2", "drp-cluster1", "drp-cluster0", "drp-cluster-missing"},
+			}}
+			Expect(ramencontrollers.DRPolicyClusterPairs(drp, drClusters)).To(Equal([]ramen.ClusterPairReplication{
+				{Clusters: []string{"drp-cluster0", "drp-cluster1"}, ReplicationMode: ramen.ReplicationModeAsync},
+				{Clusters: []string{"drp-cluster0", "drp-cluster2"}, ReplicationMode: ramen.ReplicationModeMetro},
+				{Clusters: []string{"drp-cluster1", "drp-cluster2"}, ReplicationMode: ramen.ReplicationModeAsync},
+			}))
+		})
+	})
 	When("a drpolicy is created before DRClusters are created", func() {
 		It("should start as invalidated and transition to validated", func() {
 			drp := drpolicy.DeepCopy()
@@ -310,10 +952,12 @@ var _ = Describe("DRPolicyController", func() {
 			Expect(k8sClient.Create(context.TODO(), drp)).To(Succeed())
 			By("ensuring DRPolicy is not validated")
 			validatedConditionExpect(drp, metav1.ConditionFalse, Ignore())
+			eventExpect(drp, corev1.EventTypeWarning, util.EventReasonDRPolicyValidationFailed)
 			By("creating the DRClusters")
 			createDRClusters(3, 5)
 			By("ensuring DRPolicy is validated")
 			validatedConditionExpect(drp, metav1.ConditionTrue, Ignore())
+			eventExpect(drp, corev1.EventTypeNormal, util.EventReasonDRPolicyValidated)
 			drpolicyDeleteAndConfirm(drp)
 			vaildateSecretDistribution(nil)
 		})
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+const (
+	drPolicyNotificationTimeoutDefault = 10 * time.Second
+	drPolicyNotificationRetriesDefault = 3
+	drPolicyNotificationRetryInterval  = 1 * time.Second
+	// Notifications beyond this many in flight are dropped, rather than blocking the reconcile
+	drPolicyNotificationsInFlightMax = 10
+)
+
+// DRPolicyValidationNotification is the JSON payload POSTed each time a DRPolicy becomes validated or invalid
+type DRPolicyValidationNotification struct {
+	DRPolicy  string    `json:"drpolicy"`
+	Validated bool      `json:"validated"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Time      time.Time `json:"time"`
+}
+
+// drPolicyNotifier sends DRPolicy validation notifications in the background
+type drPolicyNotifier struct {
+	inFlight      chan struct{}
+	retryInterval time.Duration
+	log           logr.Logger
+}
+
+func newDRPolicyNotifier(log logr.Logger) *drPolicyNotifier {
+	return &drPolicyNotifier{
+		inFlight:      make(chan struct{}, drPolicyNotificationsInFlightMax),
+		retryInterval: drPolicyNotificationRetryInterval,
+		log:           log,
+	}
+}
+
+// notify sends the notification to the configured URL, if any, without waiting for it to be sent
+func (n *drPolicyNotifier) notify(ramenConfig *ramen.RamenConfig, notification DRPolicyValidationNotification) {
+	if n == nil || ramenConfig == nil || ramenConfig.DRPolicyNotification.URL == "" {
+		return
+	}
+
+	url := ramenConfig.DRPolicyNotification.URL
+
+	timeout := drPolicyNotificationTimeoutDefault
+	if ramenConfig.DRPolicyNotification.TimeoutSeconds > 0 {
+		timeout = time.Duration(ramenConfig.DRPolicyNotification.TimeoutSeconds) * time.Second
+	}
+
+	retries := drPolicyNotificationRetriesDefault
+	if ramenConfig.DRPolicyNotification.Retries > 0 {
+		retries = ramenConfig.DRPolicyNotification.Retries
+	}
+
+	select {
+	case n.inFlight <- struct{}{}:
+	default:
+		n.log.Info("Too many DRPolicy notifications in flight, dropping notification",
+			"drpolicy", notification.DRPolicy, "validated", notification.Validated)
+
+		return
+	}
+
+	go func() {
+		defer func() { <-n.inFlight }()
+
+		n.send(url, timeout, retries, notification)
+	}()
+}
+
+func (n *drPolicyNotifier) send(url string, timeout time.Duration, retries int,
+	notification DRPolicyValidationNotification,
+) {
+	log := n.log.WithValues("drpolicy", notification.DRPolicy, "validated", notification.Validated)
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		log.Error(err, "Failed to marshal DRPolicy notification")
+
+		return
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = drPolicyNotificationPost(url, timeout, body)
+		if err == nil {
+			log.Info("Sent DRPolicy notification", "attempts", attempt+1)
+
+			return
+		}
+
+		if attempt >= retries {
+			log.Error(err, "Failed to send DRPolicy notification", "attempts", attempt+1)
+
+			return
+		}
+
+		time.Sleep(n.retryInterval * time.Duration(attempt+1))
+	}
+}
+
+func drPolicyNotificationPost(url string, timeout time.Duration, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to post: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// ReasonRamenOpsNamespaceExists is set when the RamenOps namespace exists on all the clusters of the DRPolicy
+const ReasonRamenOpsNamespaceExists = "RamenOpsNamespaceExists"
+
+// ReasonRamenOpsNamespaceMissing is set when the RamenOps namespace is missing on a cluster of the DRPolicy, and is
+// not created by the dr-cluster operator deployment
+const ReasonRamenOpsNamespaceMissing = "RamenOpsNamespaceMissing"
+
+// ReasonRamenOpsNamespacePending is set when the RamenOps namespace is missing on a cluster of the DRPolicy, but is
+// yet to be created by the dr-cluster operator deployment
+const ReasonRamenOpsNamespacePending = "RamenOpsNamespacePending"
+
+// ReasonRamenOpsNamespaceUnverified is set when the RamenOps namespace cannot be looked up on a cluster of the
+// DRPolicy, e.g. as its ManagedClusterView is not processed yet
+const ReasonRamenOpsNamespaceUnverified = "RamenOpsNamespaceUnverified"
+
+// ramenOpsNamespaceReconcile sets the RamenOpsNamespaceConsistent condition of the DRPolicy from the presence of the
+// RamenOps namespace, which the protection of discovered applications relies on, on each of its clusters, if a
+// RamenOps namespace is configured, or removes the condition otherwise. The namespace is only reported, the DRPolicy
+// is validated regardless.
+func (r *DRPolicyReconciler) ramenOpsNamespaceReconcile(u *drpolicyUpdater, ramenConfig *ramen.RamenConfig) error {
+	namespaceName := ramenConfig.RamenOpsNamespace
+
+	if namespaceName == "" || r.MCVGetter == nil {
+		if !meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRPolicyRamenOpsNamespaceConsistent) {
+			return nil
+		}
+
+		return u.statusUpdate()
+	}
+
+	missing, unverified := r.ramenOpsNamespaceMissingClusters(u, namespaceName)
+
+	switch {
+	case len(missing) != 0 && ramenConfig.DrClusterOperator.DeploymentAutomationEnabled:
+		return u.statusConditionSet(ramen.DRPolicyRamenOpsNamespaceConsistent, metav1.ConditionFalse,
+			ReasonRamenOpsNamespacePending, fmt.Sprintf("namespace %s missing on clusters %s, "+
+				"to be created by the dr-cluster operator deployment", namespaceName, strings.Join(missing, ", ")))
+	case len(missing) != 0:
+		return u.statusConditionSet(ramen.DRPolicyRamenOpsNamespaceConsistent, metav1.ConditionFalse,
+			ReasonRamenOpsNamespaceMissing, fmt.Sprintf("namespace %s missing on clusters %s", namespaceName,
+				strings.Join(missing, ", ")))
+	case len(unverified) != 0:
+		return u.statusConditionSet(ramen.DRPolicyRamenOpsNamespaceConsistent, metav1.ConditionUnknown,
+			ReasonRamenOpsNamespaceUnverified, fmt.Sprintf("namespace %s not verified on clusters %s",
+				namespaceName, strings.Join(unverified, ", ")))
+	}
+
+	return u.statusConditionSet(ramen.DRPolicyRamenOpsNamespaceConsistent, metav1.ConditionTrue,
+		ReasonRamenOpsNamespaceExists, fmt.Sprintf("namespace %s exists on all clusters", namespaceName))
+}
+
+// ramenOpsNamespaceMissingClusters returns the names of the clusters of the DRPolicy the namespace is missing on,
+// and of those it cannot be looked up on
+func (r *DRPolicyReconciler) ramenOpsNamespaceMissingClusters(u *drpolicyUpdater, namespaceName string,
+) ([]string, []string) {
+	missing := []string{}
+	unverified := []string{}
+
+	for _, clusterName := range util.DRPolicyClusterNames(u.object) {
+		_, err := r.MCVGetter.GetNamespaceFromManagedCluster(namespaceName, clusterName, namespaceName, nil)
+
+		switch {
+		case errors.IsNotFound(err):
+			missing = append(missing, clusterName)
+		case err != nil:
+			u.log.Info("RamenOps namespace of cluster unknown", "cluster", clusterName, "error", err.Error())
+
+			unverified = append(unverified, clusterName)
+		}
+	}
+
+	return missing, unverified
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// ReasonSchedulingIntervalSupported is set when the scheduling interval of the DRPolicy is supported by the VolSync
+// versions of all its clusters
+const ReasonSchedulingIntervalSupported = "SchedulingIntervalSupported"
+
+// ReasonSchedulingIntervalUnsupported is set when the scheduling interval of the DRPolicy is shorter than the
+// minimum supported by the VolSync version of any of its clusters
+const ReasonSchedulingIntervalUnsupported = "SchedulingIntervalUnsupported"
+
+// schedulingIntervalSupportedReconcile sets the SchedulingIntervalSupported condition of the DRPolicy from the
+// VolSync versions of its clusters, if VolSync minimum scheduling intervals are configured, or removes the condition
+// otherwise. The condition is unknown while the VolSync version of any cluster is not verified. Clusters without a
+// known VolSync version are left out.
+func (r *DRPolicyReconciler) schedulingIntervalSupportedReconcile(u *drpolicyUpdater,
+	minIntervals []ramen.VolSyncMinSchedulingInterval, versions *drClusterVersions,
+) error {
+	if len(minIntervals) == 0 || versions == nil {
+		if !meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRPolicySchedulingIntervalSupported) {
+			return nil
+		}
+
+		return u.statusUpdate()
+	}
+
+	if len(versions.volSyncUnverified) != 0 {
+		return u.statusConditionSet(ramen.DRPolicySchedulingIntervalSupported, metav1.ConditionUnknown,
+			ReasonVersionsUnverified, fmt.Sprintf("VolSync versions not verified on clusters %s",
+				strings.Join(versions.volSyncUnverified, ", ")))
+	}
+
+	unsupported, err := schedulingIntervalUnsupported(u.object.Spec.SchedulingInterval, versions.volSync, minIntervals)
+	if err != nil {
+		return u.statusConditionSet(ramen.DRPolicySchedulingIntervalSupported, metav1.ConditionFalse,
+			ReasonSchedulingIntervalUnsupported, err.Error())
+	}
+
+	if len(unsupported) == 0 {
+		return u.statusConditionSet(ramen.DRPolicySchedulingIntervalSupported, metav1.ConditionTrue,
+			ReasonSchedulingIntervalSupported, "scheduling interval is supported by the VolSync versions of the DRClusters")
+	}
+
+	return u.statusConditionSet(ramen.DRPolicySchedulingIntervalSupported, metav1.ConditionFalse,
+		ReasonSchedulingIntervalUnsupported, strings.Join(unsupported, "; "))
+}
+
+// schedulingIntervalUnsupported returns a message for each cluster, in cluster name order, whose VolSync version
+// does not support the scheduling interval. Unparsable cluster versions are ignored, as is a DRPolicy without a
+// scheduling interval, which does not replicate using VolSync.
+func schedulingIntervalUnsupported(schedulingInterval string, volSyncVersions map[string]string,
+	minIntervals []ramen.VolSyncMinSchedulingInterval,
+) ([]string, error) {
+	if schedulingInterval == "" {
+		return nil, nil
+	}
+
+	seconds, err := util.SchedulingIntervalSeconds(schedulingInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduling interval %s: %w", schedulingInterval, err)
+	}
+
+	clusterNames := make([]string, 0, len(volSyncVersions))
+	for clusterName := range volSyncVersions {
+		clusterNames = append(clusterNames, clusterName)
+	}
+
+	sort.Strings(clusterNames)
+
+	unsupported := []string{}
+
+	for _, clusterName := range clusterNames {
+		clusterVersion, err := version.ParseGeneric(volSyncVersions[clusterName])
+		if err != nil {
+			continue
+		}
+
+		minInterval, err := volSyncMinSchedulingInterval(clusterVersion, minIntervals)
+		if err != nil {
+			return nil, err
+		}
+
+		if minInterval == "" {
+			continue
+		}
+
+		minSeconds, err := util.SchedulingIntervalSeconds(minInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VolSync minimum scheduling interval %s in ramen config: %w",
+				minInterval, err)
+		}
+
+		if seconds < minSeconds {
+			unsupported = append(unsupported, fmt.Sprintf(
+				"scheduling interval %s is shorter than the minimum %s supported by VolSync %s of cluster %s",
+				schedulingInterval, minInterval, volSyncVersions[clusterName], clusterName))
+		}
+	}
+
+	return unsupported, nil
+}
+
+// volSyncMinSchedulingInterval returns the minimum scheduling interval of the highest version of the minimums that is
+// not newer than the VolSync version, or an empty string if all are newer
+func volSyncMinSchedulingInterval(volSyncVersion *version.Version,
+	minIntervals []ramen.VolSyncMinSchedulingInterval,
+) (string, error) {
+	var highest *version.Version
+
+	minInterval := ""
+
+	for _, candidate := range minIntervals {
+		candidateVersion, err := version.ParseGeneric(candidate.Version)
+		if err != nil {
+			return "", fmt.Errorf("invalid VolSync version %s in ramen config: %w", candidate.Version, err)
+		}
+
+		if volSyncVersion.LessThan(candidateVersion) || (highest != nil && candidateVersion.LessThan(highest)) {
+			continue
+		}
+
+		highest = candidateVersion
+		minInterval = candidate.MinSchedulingInterval
+	}
+
+	return minInterval, nil
+}
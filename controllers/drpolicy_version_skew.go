@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ocmclv1 "github.com/open-cluster-management/api/cluster/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+	"github.com/ramendr/ramen/controllers/volsync"
+)
+
+// ReasonVersionSkewExceeded is set when the versions of the DRClusters of the DRPolicy are further apart than allowed
+const ReasonVersionSkewExceeded = "VersionSkewExceeded"
+
+// ReasonVersionsCompatible is set when the versions of the DRClusters of the DRPolicy are within the allowed skew
+const ReasonVersionsCompatible = "VersionsCompatible"
+
+// ReasonVersionsUnverified is set when the versions of a DRCluster of the DRPolicy cannot be looked up, e.g. as its
+// ManagedClusterView is not processed yet
+const ReasonVersionsUnverified = "VersionsUnverified"
+
+const defaultMaxVolSyncMinorVersionSkew = 1
+
+// versionsUnverifiedRequeueDelay is the delay after which the versions of the clusters of a DRPolicy are looked up
+// again, if any could not be verified
+const versionsUnverifiedRequeueDelay = 30 * time.Second
+
+// drClusterVersions holds the VolSync, and Kubernetes, versions of the clusters of a DRPolicy, by cluster name, and
+// the names of the clusters whose versions could not be verified
+type drClusterVersions struct {
+	volSync              map[string]string
+	kubernetes           map[string]string
+	volSyncUnverified    []string
+	kubernetesUnverified []string
+}
+
+// unverified returns the sorted names of the clusters whose VolSync, or Kubernetes, versions could not be verified
+func (versions *drClusterVersions) unverified() []string {
+	clusterNames := sets.NewString(versions.volSyncUnverified...).Insert(versions.kubernetesUnverified...)
+
+	return clusterNames.List()
+}
+
+// versionSkewReconcile sets the VersionSkew condition of the DRPolicy from the VolSync, and optionally Kubernetes,
+// versions of its clusters, if version skew validation is enabled, or removes the condition otherwise. The condition
+// is unknown while the versions of any cluster are not verified. The skew is only reported, the DRPolicy is validated
+// regardless.
+func (r *DRPolicyReconciler) versionSkewReconcile(u *drpolicyUpdater, validation ramen.VersionSkewValidation,
+	versions *drClusterVersions,
+) error {
+	if !validation.Enabled || versions == nil {
+		if !meta.RemoveStatusCondition(&u.object.Status.Conditions, ramen.DRPolicyVersionSkew) {
+			return nil
+		}
+
+		return u.statusUpdate()
+	}
+
+	if unverified := versions.unverified(); len(unverified) != 0 {
+		return u.statusConditionSet(ramen.DRPolicyVersionSkew, metav1.ConditionUnknown, ReasonVersionsUnverified,
+			fmt.Sprintf("versions not verified on clusters %s", strings.Join(unverified, ", ")))
+	}
+
+	maxVolSyncSkew := validation.MaxVolSyncMinorVersionSkew
+	if maxVolSyncSkew <= 0 {
+		maxVolSyncSkew = defaultMaxVolSyncMinorVersionSkew
+	}
+
+	skews := []string{}
+
+	if message, exceeded := minorVersionSkewExceeded("VolSync", versions.volSync, maxVolSyncSkew); exceeded {
+		skews = append(skews, message)
+	}
+
+	if validation.MaxKubernetesMinorVersionSkew > 0 {
+		message, exceeded := minorVersionSkewExceeded("Kubernetes", versions.kubernetes,
+			validation.MaxKubernetesMinorVersionSkew)
+		if exceeded {
+			skews = append(skews, message)
+		}
+	}
+
+	if len(skews) == 0 {
+		return u.statusConditionSet(ramen.DRPolicyVersionSkew, metav1.ConditionFalse, ReasonVersionsCompatible,
+			"versions of the DRClusters are within the allowed skew")
+	}
+
+	return u.statusConditionSet(ramen.DRPolicyVersionSkew, metav1.ConditionTrue, ReasonVersionSkewExceeded,
+		strings.Join(skews, "; "))
+}
+
+// clusterVersions looks up the versions of the clusters of the DRPolicy once for the conditions reported from them,
+// the VolSync versions if version skew validation is enabled or VolSync minimum scheduling intervals are configured,
+// and the Kubernetes versions if their skew is validated. It returns nil if no versions are needed. The VolSync
+// version is read from the labels of its ReplicationDestination CRD on the cluster, and the Kubernetes version from
+// the status of the ManagedCluster. Clusters without VolSync, or without a VolSync version label, are left out, and
+// clusters whose versions cannot be looked up, e.g. as their ManagedClusterView is not processed yet, are unverified.
+func (r *DRPolicyReconciler) clusterVersions(u *drpolicyUpdater, ramenConfig *ramen.RamenConfig) *drClusterVersions {
+	validation := ramenConfig.DRPolicyVersionSkewValidation
+
+	if r.MCVGetter == nil || (!validation.Enabled && len(ramenConfig.VolSyncMinSchedulingIntervals) == 0) {
+		return nil
+	}
+
+	kubernetes := validation.Enabled && validation.MaxKubernetesMinorVersionSkew > 0
+	versions := &drClusterVersions{volSync: map[string]string{}, kubernetes: map[string]string{}}
+
+	for _, clusterName := range util.DRPolicyClusterNames(u.object) {
+		crd, err := r.MCVGetter.GetCRDFromManagedCluster(volsync.ReplicationDestinationCRDName, clusterName, nil)
+
+		switch {
+		case errors.IsNotFound(err):
+			u.log.Info("VolSync not found on cluster", "cluster", clusterName)
+		case err != nil:
+			u.log.Info("VolSync version of cluster unknown", "cluster", clusterName, "error", err.Error())
+
+			versions.volSyncUnverified = append(versions.volSyncUnverified, clusterName)
+		case crd.GetLabels()[volsync.VolSyncVersionLabel] != "":
+			versions.volSync[clusterName] = crd.GetLabels()[volsync.VolSyncVersionLabel]
+		}
+
+		if !kubernetes {
+			continue
+		}
+
+		managedCluster := &ocmclv1.ManagedCluster{}
+		if err := r.APIReader.Get(u.ctx, types.NamespacedName{Name: clusterName}, managedCluster); err != nil ||
+			managedCluster.Status.Version.Kubernetes == "" {
+			u.log.Info("Kubernetes version of cluster unknown", "cluster", clusterName, "error", err)
+
+			versions.kubernetesUnverified = append(versions.kubernetesUnverified, clusterName)
+
+			continue
+		}
+
+		versions.kubernetes[clusterName] = managedCluster.Status.Version.Kubernetes
+	}
+
+	return versions
+}
+
+// minorVersionSkewExceeded returns true, and a message listing the versions by cluster, if the versions of the
+// component differ by major version, or by more than maxSkew minor versions. Unparsable versions are ignored.
+func minorVersionSkewExceeded(component string, versions map[string]string, maxSkew int) (string, bool) {
+	var lowest, highest *version.Version
+
+	clusterVersions := make([]string, 0, len(versions))
+
+	for clusterName, versionString := range versions {
+		clusterVersions = append(clusterVersions, fmt.Sprintf("%s: %s", clusterName, versionString))
+
+		parsed, err := version.ParseGeneric(versionString)
+		if err != nil {
+			continue
+		}
+
+		if lowest == nil || parsed.LessThan(lowest) {
+			lowest = parsed
+		}
+
+		if highest == nil || highest.LessThan(parsed) {
+			highest = parsed
+		}
+	}
+
+	if lowest == nil || (lowest.Major() == highest.Major() && int(highest.Minor()-lowest.Minor()) <= maxSkew) {
+		return "", false
+	}
+
+	sort.Strings(clusterVersions)
+
+	return fmt.Sprintf("%s versions skew by more than %d minor versions (%s)", component, maxSkew,
+		strings.Join(clusterVersions, "; ")), true
+}
@@ -14,7 +14,8 @@ const (
 )
 
 const (
-	DRPolicySyncIntervalSeconds = "policy_schedule_interval_seconds"
+	DRPolicySyncIntervalSeconds       = "policy_schedule_interval_seconds"
+	DRPolicyValidationDurationSeconds = "policy_validation_duration_seconds"
 )
 
 const (
@@ -24,6 +25,12 @@ const (
 	WorkloadProtectionStatus = "workload_protection_status"
 )
 
+const (
+	ProtectedDataBytes        = "protected_data_bytes"
+	ObjectStoreDataBytes      = "object_store_data_bytes"
+	VolSyncProtectedDataBytes = "volsync_protected_data_bytes"
+)
+
 type SyncTimeMetrics struct {
 	LastSyncTime prometheus.Gauge
 }
@@ -32,6 +39,10 @@ type DRPolicySyncMetrics struct {
 	DRPolicySyncInterval prometheus.Gauge
 }
 
+type DRPolicyValidationMetrics struct {
+	DRPolicyValidationDuration prometheus.Observer
+}
+
 type SyncDurationMetrics struct {
 	LastSyncDuration prometheus.Gauge
 }
@@ -44,6 +55,12 @@ type WorkloadProtectionMetrics struct {
 	WorkloadProtectionStatus prometheus.Gauge
 }
 
+type DataSizeMetrics struct {
+	ProtectedDataBytes        prometheus.Gauge
+	ObjectStoreDataBytes      prometheus.Gauge
+	VolSyncProtectedDataBytes prometheus.Gauge
+}
+
 type SyncMetrics struct {
 	SyncTimeMetrics
 	SyncDurationMetrics
@@ -71,6 +88,10 @@ var (
 		Policyname, // DRPolicy name
 	}
 
+	drpolicyValidationDurationMetricLabelNames = []string{
+		Policyname, // DRPolicy name
+	}
+
 	syncDurationMetricLabelNames = []string{
 		ObjType,            // Name of the type of the resource [drpc]
 		ObjName,            // Name of the resoure [drpc-name]
@@ -90,6 +111,12 @@ var (
 		ObjName,      // Name of the resoure [drpc-name]
 		ObjNamespace, // DRPC namespace
 	}
+
+	dataSizeMetricLabelNames = []string{
+		ObjType,      // Name of the type of the resource [vrg]
+		ObjName,      // Name of the resoure [vrg-name]
+		ObjNamespace, // VRG namespace
+	}
 )
 
 var (
@@ -111,6 +138,16 @@ var (
 		drpolicySyncIntervalMetricLabelNames,
 	)
 
+	dRPolicyValidationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:      DRPolicyValidationDurationSeconds,
+			Namespace: metricNamespace,
+			Help:      "Duration from the first reconcile of a policy to its successful validation in seconds",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12), //nolint:gomnd
+		},
+		drpolicyValidationDurationMetricLabelNames,
+	)
+
 	lastSyncDuration = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      LastSyncDurationSeconds,
@@ -137,6 +174,33 @@ var (
 		},
 		workloadProtectionStatusLabels,
 	)
+
+	protectedDataBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      ProtectedDataBytes,
+			Namespace: metricNamespace,
+			Help:      "Total storage requested by the protected PVCs in bytes",
+		},
+		dataSizeMetricLabelNames,
+	)
+
+	objectStoreDataBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      ObjectStoreDataBytes,
+			Namespace: metricNamespace,
+			Help:      "Total size of the objects stored in the S3 stores in bytes",
+		},
+		dataSizeMetricLabelNames,
+	)
+
+	volSyncProtectedDataBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      VolSyncProtectedDataBytes,
+			Namespace: metricNamespace,
+			Help:      "Total data protected by the VolSync ReplicationDestinations in bytes",
+		},
+		dataSizeMetricLabelNames,
+	)
 )
 
 // lastSyncTime metrics reports value from lastGrpupSyncTime taken from DRPC status
@@ -175,6 +239,21 @@ func DeleteDRPolicySyncIntervalMetrics(labels prometheus.Labels) bool {
 	return dRPolicySyncInterval.Delete(labels)
 }
 
+// dRPolicyValidationDuration Metrics reports the time a DRPolicy took to be validated
+func DRPolicyValidationDurationMetricLabels(drPolicy *rmn.DRPolicy) prometheus.Labels {
+	return prometheus.Labels{Policyname: drPolicy.Name}
+}
+
+func NewDRPolicyValidationDurationMetrics(labels prometheus.Labels) DRPolicyValidationMetrics {
+	return DRPolicyValidationMetrics{
+		DRPolicyValidationDuration: dRPolicyValidationDuration.With(labels),
+	}
+}
+
+func DeleteDRPolicyValidationDurationMetrics(labels prometheus.Labels) bool {
+	return dRPolicyValidationDuration.Delete(labels)
+}
+
 // lastSyncDuration Metrics reports value from lastGroupSyncDuration from DRPC status
 func SyncDurationMetricLabels(drPolicy *rmn.DRPolicy, drpc *rmn.DRPlacementControl) prometheus.Labels {
 	return prometheus.Labels{
@@ -234,11 +313,40 @@ func DeleteWorkloadProtectionStatusMetric(labels prometheus.Labels) bool {
 	return workloadProtectionStatus.Delete(labels)
 }
 
+// dataSizeMetrics report the size of the data protected by the VRG and of its objects in the S3 stores
+func DataSizeMetricLabels(vrg *rmn.VolumeReplicationGroup) prometheus.Labels {
+	return prometheus.Labels{
+		ObjType:      "VolumeReplicationGroup",
+		ObjName:      vrg.Name,
+		ObjNamespace: vrg.Namespace,
+	}
+}
+
+func NewDataSizeMetrics(labels prometheus.Labels) DataSizeMetrics {
+	return DataSizeMetrics{
+		ProtectedDataBytes:        protectedDataBytes.With(labels),
+		ObjectStoreDataBytes:      objectStoreDataBytes.With(labels),
+		VolSyncProtectedDataBytes: volSyncProtectedDataBytes.With(labels),
+	}
+}
+
+func DeleteDataSizeMetrics(labels prometheus.Labels) bool {
+	protectedDeleted := protectedDataBytes.Delete(labels)
+	objectStoreDeleted := objectStoreDataBytes.Delete(labels)
+	volSyncProtectedDeleted := volSyncProtectedDataBytes.Delete(labels)
+
+	return protectedDeleted || objectStoreDeleted || volSyncProtectedDeleted
+}
+
 func init() {
 	// Register custom metrics with the global prometheus registry
 	metrics.Registry.MustRegister(dRPolicySyncInterval)
+	metrics.Registry.MustRegister(dRPolicyValidationDuration)
 	metrics.Registry.MustRegister(lastSyncTime)
 	metrics.Registry.MustRegister(lastSyncDuration)
 	metrics.Registry.MustRegister(lastSyncDataBytes)
 	metrics.Registry.MustRegister(workloadProtectionStatus)
+	metrics.Registry.MustRegister(protectedDataBytes)
+	metrics.Registry.MustRegister(objectStoreDataBytes)
+	metrics.Registry.MustRegister(volSyncProtectedDataBytes)
 }
@@ -5,10 +5,13 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
@@ -41,6 +44,8 @@ const (
 	DefaultCephFSCSIDriverName                        = "openshift-storage.cephfs.csi.ceph.com"
 	VeleroNamespaceNameDefault                        = "velero"
 	DefaultVolSyncCopyMethod                          = "Snapshot"
+	pvcEventsCaptureMaxEventsDefault                  = 20
+	pvcEventsCaptureMaxAgeSecondsDefault              = 24 * 60 * 60
 )
 
 var (
@@ -127,7 +132,11 @@ func GetRamenConfigS3StoreProfile(ctx context.Context, apiReader client.Reader,
 
 	s3StoreProfile = *s3StoreProfilePointer
 
-	err = s3StoreProfileFormatCheck(&s3StoreProfile)
+	if err = s3StoreProfileFormatCheck(&s3StoreProfile); err != nil {
+		return
+	}
+
+	err = s3StoreProfileEndpointAllowed(&s3StoreProfile, ramenConfig.S3EndpointAllowlist)
 
 	return
 }
@@ -172,6 +181,32 @@ func s3StoreProfileFormatCheck(s3StoreProfile *ramendrv1alpha1.S3StoreProfile) (
 	return nil
 }
 
+// ErrS3EndpointNotAllowed is returned for an s3 profile whose endpoint host is not in the S3 endpoint allowlist
+var ErrS3EndpointNotAllowed = errors.New("s3 endpoint host is not allowlisted")
+
+// s3StoreProfileEndpointAllowed checks the host of the endpoint of the s3 profile, already checked to be a valid
+// URL, is in the allowlist, if the allowlist is not empty
+func s3StoreProfileEndpointAllowed(s3StoreProfile *ramendrv1alpha1.S3StoreProfile, allowlist []string) error {
+	if len(allowlist) == 0 {
+		return nil
+	}
+
+	endpoint, err := url.Parse(s3StoreProfile.S3CompatibleEndpoint)
+	if err != nil {
+		return fmt.Errorf("invalid s3 endpoint <%s> in profile %s, reason: %w",
+			s3StoreProfile.S3CompatibleEndpoint, s3StoreProfile.S3ProfileName, err)
+	}
+
+	for _, host := range allowlist {
+		if strings.EqualFold(host, endpoint.Hostname()) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, host %s of endpoint <%s> in profile %s, allowed hosts: %v", ErrS3EndpointNotAllowed,
+		endpoint.Hostname(), s3StoreProfile.S3CompatibleEndpoint, s3StoreProfile.S3ProfileName, allowlist)
+}
+
 func getMaxConcurrentReconciles(log logr.Logger) int {
 	const defaultMaxConcurrentReconciles = 1
 
@@ -322,3 +357,19 @@ func volSyncDestinationCopyMethodOrDefault(ramenConfig *ramendrv1alpha1.RamenCon
 
 	return ramenConfig.VolSync.DestinationCopyMethod
 }
+
+func pvcEventsCaptureMaxEventsOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) int {
+	if ramenConfig.PVCEventsCapture.MaxEvents <= 0 {
+		return pvcEventsCaptureMaxEventsDefault
+	}
+
+	return ramenConfig.PVCEventsCapture.MaxEvents
+}
+
+func pvcEventsCaptureMaxAgeOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) time.Duration {
+	if ramenConfig.PVCEventsCapture.MaxAgeSeconds <= 0 {
+		return pvcEventsCaptureMaxAgeSecondsDefault * time.Second
+	}
+
+	return time.Duration(ramenConfig.PVCEventsCapture.MaxAgeSeconds) * time.Second
+}
@@ -93,6 +93,7 @@ type ObjectStorer interface {
 	UploadObject(key string, object interface{}) error
 	DownloadObject(key string, objectPointer interface{}) error
 	ListKeys(keyPrefix string) (keys []string, err error)
+	ObjectsSize(keyPrefix string) (size int64, err error)
 	DeleteObject(key string) error
 	DeleteObjects(key ...string) error
 	DeleteObjectsWithKeyPrefix(keyPrefix string) error
@@ -365,6 +366,26 @@ func UploadPVC(s ObjectStorer, pvcKeyPrefix, pvcKeySuffix string,
 	return uploadTypedObject(s, pvcKeyPrefix, pvcKeySuffix, pvc)
 }
 
+// UploadPVCEvents uploads the given events of a PVC to the bucket with a key of
+// "<pvcKeyPrefix><v1.EventList/><pvcKeySuffix>".
+// - pvcKeyPrefix should have any required delimiters like '/'
+// - OK to call UploadPVCEvents() concurrently from multiple goroutines safely.
+func UploadPVCEvents(s ObjectStorer, pvcKeyPrefix, pvcKeySuffix string,
+	events corev1.EventList,
+) error {
+	return uploadTypedObject(s, pvcKeyPrefix, pvcKeySuffix, events)
+}
+
+// DownloadPVCEvents downloads the events of a PVC uploaded by UploadPVCEvents(),
+// e.g. to diagnose a failover of the PVC.
+func DownloadPVCEvents(s ObjectStorer, pvcKeyPrefix, pvcKeySuffix string) (
+	events corev1.EventList, err error,
+) {
+	err = DownloadTypedObject(s, pvcKeyPrefix, pvcKeySuffix, &events)
+
+	return
+}
+
 // uploadTypedObject uploads to the bucket the given uploadContent with a
 // key of <keyPrefix><objectType/>keySuffix>, where objectType is the type of the
 // uploadContent parameter. OK to call uploadTypedObject() concurrently from
@@ -542,6 +563,47 @@ func (s *s3ObjectStore) ListKeys(keyPrefix string) (
 	return keys, nil
 }
 
+// ObjectsSize returns the total size in bytes of the objects with the given
+// keyPrefix in the bucket, as stored, i.e. compressed.
+// - If bucket doesn't exists, will return ErrCodeNoSuchBucket "NoSuchBucket"
+func (s *s3ObjectStore) ObjectsSize(keyPrefix string) (
+	size int64, err error,
+) {
+	var nextContinuationToken *string
+
+	bucket := s.s3Bucket
+
+	ctx, cancel := context.WithDeadline(context.TODO(), time.Now().Add(s3Timeout))
+	defer cancel()
+
+	for gotAllObjects := false; !gotAllObjects; {
+		result, err := s.client.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            &bucket,
+			Prefix:            &keyPrefix,
+			ContinuationToken: nextContinuationToken,
+		})
+		if err != nil {
+			errMsgPrefix := fmt.Errorf("failed to list objects in bucket")
+
+			return 0, processAwsError(errMsgPrefix, err)
+		}
+
+		for _, entry := range result.Contents {
+			if entry.Size != nil {
+				size += *entry.Size
+			}
+		}
+
+		if *result.IsTruncated {
+			nextContinuationToken = result.NextContinuationToken
+		} else {
+			gotAllObjects = true
+		}
+	}
+
+	return size, nil
+}
+
 // DownloadObject downloads an object from the bucket with the given key,
 // unzips, decodes the json blob and stores the downloaded object in the
 // downloadContent parameter.  The caller is expected to use the correct type of
@@ -5,6 +5,7 @@ package controllers_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"reflect"
@@ -17,6 +18,7 @@ import (
 	. "github.com/onsi/gomega"
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/controllers"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -128,6 +130,29 @@ func (f fakeObjectStorer) ListKeys(keyPrefix string) ([]string, error) {
 	return keys, nil
 }
 
+func (f fakeObjectStorer) ObjectsSize(keyPrefix string) (int64, error) {
+	if f.bucketName == bucketListFail {
+		return 0, fmt.Errorf("Failing bucket listing")
+	}
+
+	var size int64
+
+	for k, object := range f.objects {
+		if !strings.HasPrefix(k, keyPrefix) {
+			continue
+		}
+
+		data, err := json.Marshal(object)
+		if err != nil {
+			return 0, err
+		}
+
+		size += int64(len(data))
+	}
+
+	return size, nil
+}
+
 func (f fakeObjectStorer) DeleteObject(key string) error {
 	delete(f.objects, key)
 
@@ -195,4 +220,79 @@ var _ = Describe("FakeObjectStorer", func() {
 			Expect(objectStorer.DeleteObject(key2)).To(Succeed())
 		})
 	})
+	Context("ObjectsSize", func() {
+		const keyPrefix = "size/"
+		BeforeEach(func() {
+			Expect(objectStorer.UploadObject(keyPrefix+key, object)).To(Succeed())
+			Expect(objectStorer.UploadObject(keyPrefix+key1, object)).To(Succeed())
+			Expect(objectStorer.UploadObject(key2, object)).To(Succeed())
+		})
+		AfterEach(func() {
+			Expect(objectStorer.DeleteObjectsWithKeyPrefix(keyPrefix)).To(Succeed())
+		})
+		It("should sum the size of the objects with the specified key prefix only", func() {
+			Expect(objectStorer.ObjectsSize(keyPrefix)).To(Equal(int64(2 * len(`"o"`))))
+		})
+		It("should return zero if no object with the specified key prefix was uploaded", func() {
+			Expect(objectStorer.ObjectsSize(keyPrefix + key2)).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("PVC events", func() {
+	var objectStorer controllers.ObjectStorer
+
+	const (
+		keyPrefix    = "ns/vrg/"
+		pvcKeySuffix = "ns/pvc"
+	)
+
+	BeforeEach(func() {
+		objectStorer = objectStorers[objS3ProfileNumber]
+	})
+	It("should download uploaded PVC events", func() {
+		events := corev1.EventList{Items: []corev1.Event{{Reason: "FailedMount"}}}
+		Expect(controllers.UploadPVCEvents(objectStorer, keyPrefix, pvcKeySuffix, events)).To(Succeed())
+
+		downloaded, err := controllers.DownloadPVCEvents(objectStorer, keyPrefix, pvcKeySuffix)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(downloaded).To(Equal(events))
+	})
+	It("should not download events of a PVC whose events were not uploaded", func() {
+		_, err := controllers.DownloadPVCEvents(objectStorer, keyPrefix, "ns/other")
+		Expect(err).To(MatchError(fs.ErrNotExist))
+	})
+})
+
+var _ = Describe("S3 endpoint allowlist", func() {
+	var s3ProfileName string
+
+	profileGet := func() error {
+		_, err := controllers.GetRamenConfigS3StoreProfile(context.TODO(), apiReader, s3ProfileName)
+
+		return err
+	}
+	allowlistSet := func(hosts ...string) {
+		ramenConfig.S3EndpointAllowlist = hosts
+		configMapUpdate()
+	}
+
+	BeforeEach(func() {
+		s3ProfileName = s3Profiles[objS3ProfileNumber].S3ProfileName
+		DeferCleanup(func() { allowlistSet() })
+	})
+	It("should allow any endpoint if the allowlist is empty", func() {
+		Expect(profileGet()).To(Succeed())
+	})
+	It("should allow an endpoint whose host is allowlisted", func() {
+		allowlistSet("s3.example.com", "192.168.39.223")
+		Expect(profileGet()).To(Succeed())
+	})
+	It("should reject an endpoint whose host is not allowlisted, naming the profile", func() {
+		allowlistSet("s3.example.com")
+		Expect(profileGet()).To(SatisfyAll(
+			MatchError(controllers.ErrS3EndpointNotAllowed),
+			MatchError(ContainSubstring(s3ProfileName)),
+		))
+	})
 })
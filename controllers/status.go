@@ -35,6 +35,24 @@ const (
 	// protected from a disaster by uploading it to the required S3 store(s).
 	VRGConditionTypeClusterDataProtected = "ClusterDataProtected"
 
+	// VolSync reconciliation is paused.  This condition is only present while
+	// the VRG is annotated to pause VolSync, and is not counted towards the
+	// total number of conditions below.
+	VRGConditionTypeVolSyncPaused = "Paused"
+
+	// A PVC has both a ReplicationSource and a ReplicationDestination.  This
+	// condition is only present while such PVCs are found, until their
+	// conflicting object is cleaned up, and is not counted towards the total
+	// number of conditions below.
+	VRGConditionTypeVolSyncReplicationConflict = "ReplicationConflict"
+
+	// A ReplicationSource or ReplicationDestination is labeled as owned by a
+	// VRG of the same name in a namespace that no longer has that VRG, and
+	// cannot be migrated to this VRG.  This condition is only present while
+	// such objects are found, and is not counted towards the total number of
+	// conditions below.
+	VRGConditionTypeVolSyncStaleOwnerNamespace = "StaleOwnerNamespace"
+
 	// Total number of condition types in VRG as of now. Change this value
 	// when a new condition type is added to VRG or an existing condition
 	// type is removed from VRG status.
@@ -46,6 +64,10 @@ const (
 	VRGConditionTypeVolSyncFinalSyncInProgress = "FinalSyncInProgress"
 	VRGConditionTypeVolSyncRepDestinationSetup = "ReplicationDestinationSetup"
 	VRGConditionTypeVolSyncPVsRestored         = "PVsRestored"
+
+	// The PVC is owned by the VRG and annotated for ACM not to delete it when
+	// its appsub is removed, as required before its final sync.
+	VRGConditionTypeVolSyncAppsubOwnershipReleased = "AppsubOwnershipReleased"
 )
 
 // VRG condition reasons
@@ -68,7 +90,21 @@ const (
 	VRGConditionReasonVolSyncPVsRestored          = "Restored"
 	VRGConditionReasonVolSyncFinalSyncInProgress  = "Syncing"
 	VRGConditionReasonVolSyncFinalSyncComplete    = "Synced"
+	VRGConditionReasonVolSyncPaused               = "VolSyncPaused"
+	VRGConditionReasonSourceAndDestinationPresent = "SourceAndDestinationPresent"
+	VRGConditionReasonAccessModeNotSupported      = "AccessModeNotSupported"
+	VRGConditionReasonSnapshotNotReady            = "SnapshotNotReady"
+	VRGConditionReasonSnapshotClassMismatch       = "VolumeSnapshotClassMismatch"
+	VRGConditionReasonSourcePVCMissing            = "SourcePVCMissing"
+	VRGConditionReasonSkippedTooSmall             = "SkippedTooSmall"
+	VRGConditionReasonOwnershipReleased           = "OwnershipReleased"
+	VRGConditionReasonOwnershipReleasePending     = "OwnershipReleasePending"
 	VRGConditionReasonClusterDataAnnotationFailed = "AnnotationFailed"
+	VRGConditionReasonVolSyncRBACMissing          = "VolSyncRBACMissing"
+	VRGConditionReasonPVCTerminating              = "PVCTerminating"
+	VRGConditionReasonFinalSyncTimedOut           = "FinalSyncTimedOut"
+	VRGConditionReasonStaleOwnerNamespaceObjects  = "StaleOwnerNamespaceObjects"
+	VRGConditionReasonRequiredLabelsMissing       = "RequiredLabelsMissing"
 )
 
 const clusterDataProtectedTrueMessage = "Kube objects protected"
@@ -434,6 +470,141 @@ func setVRGConditionTypeVolSyncRepSourceSetupError(conditions *[]metav1.Conditio
 	})
 }
 
+// sets conditions when Primary cannot initialize the Replication Source as the VolumeSnapshotClass its storage
+// class is annotated to use has a different driver
+func setVRGConditionTypeVolSyncRepSourceSetupSnapshotClassMismatch(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonSnapshotClassMismatch,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when Secondary cannot reconcile the Replication Destination as the access modes of the PVC are not
+// supported by its storage class
+func setVRGConditionTypeVolSyncRepDestinationSetupAccessModeNotSupported(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepDestinationSetup,
+		Reason:             VRGConditionReasonAccessModeNotSupported,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when Primary cannot reconcile the Replication Source as its source PVC is missing
+func setVRGConditionTypeVolSyncRepSourceSetupSourcePVCMissing(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonSourcePVCMissing,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when Primary cannot reconcile the Replication Source as the PVC namespace lacks the permissions
+// VolSync movers need
+func setVRGConditionTypeVolSyncRepSourceSetupRBACMissing(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonVolSyncRBACMissing,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when Primary does not reconcile the Replication Source as its source PVC, or the PVC namespace, is
+// being deleted
+func setVRGConditionTypeVolSyncRepSourceSetupPVCTerminating(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonPVCTerminating,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when the final sync of the PVC did not complete within the final sync timeout
+func setVRGConditionTypeVolSyncRepSourceSetupFinalSyncTimedOut(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonFinalSyncTimedOut,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when the VRG has taken ownership of the PVC from its appsub, for its final sync
+func setVRGConditionTypeVolSyncAppsubOwnershipReleased(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncAppsubOwnershipReleased,
+		Reason:             VRGConditionReasonOwnershipReleased,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionTrue,
+		Message:            message,
+	})
+}
+
+// sets conditions when the VRG is still waiting to take ownership of the PVC from its appsub
+func setVRGConditionTypeVolSyncAppsubOwnershipReleasePending(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncAppsubOwnershipReleased,
+		Reason:             VRGConditionReasonOwnershipReleasePending,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when the PVC is not protected using VolSync, as it is smaller than the configured minimum size.
+// The condition is True, as there is nothing left to set up for the PVC.
+func setVRGConditionTypeVolSyncRepSourceSetupSkippedTooSmall(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncRepSourceSetup,
+		Reason:             VRGConditionReasonSkippedTooSmall,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionTrue,
+		Message:            message,
+	})
+}
+
+// sets conditions when the PVC is not restored using VolSync, as it is smaller than the configured minimum size
+func setVRGConditionTypeVolSyncPVRestoreSkippedTooSmall(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPVsRestored,
+		Reason:             VRGConditionReasonSkippedTooSmall,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionTrue,
+		Message:            message,
+	})
+}
+
 // sets conditions when Primary VolSync has finished setting up the Replication Destination
 func setVRGConditionTypeVolSyncPVRestoreComplete(conditions *[]metav1.Condition, observedGeneration int64,
 	message string,
@@ -459,3 +630,55 @@ func setVRGConditionTypeVolSyncPVRestoreError(conditions *[]metav1.Condition, ob
 		Message:            message,
 	})
 }
+
+// sets conditions when a PVC cannot be restored yet as the snapshot to restore it from is not ready to use
+func setVRGConditionTypeVolSyncPVRestoreSnapshotNotReady(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPVsRestored,
+		Reason:             VRGConditionReasonSnapshotNotReady,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when a restored PVC does not have all the labels required by the selectors of the workload
+func setVRGConditionTypeVolSyncPVRestoreRequiredLabelsMissing(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPVsRestored,
+		Reason:             VRGConditionReasonRequiredLabelsMissing,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when a PVC is not restored as it, or its namespace, is being deleted
+func setVRGConditionTypeVolSyncPVRestorePVCTerminating(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPVsRestored,
+		Reason:             VRGConditionReasonPVCTerminating,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
+// sets conditions when a PVC cannot be restored as its access modes are not supported by its storage class
+func setVRGConditionTypeVolSyncPVRestoreAccessModeNotSupported(conditions *[]metav1.Condition,
+	observedGeneration int64, message string,
+) {
+	setStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPVsRestored,
+		Reason:             VRGConditionReasonAccessModeNotSupported,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
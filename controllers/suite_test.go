@@ -240,6 +240,7 @@ var _ = BeforeSuite(func() {
 	ramenConfig.DrClusterOperator.DeploymentAutomationEnabled = true
 	ramenConfig.DrClusterOperator.S3SecretDistributionEnabled = true
 	ramenConfig.MultiNamespace.FeatureEnabled = true
+	ramenConfig.VolSync.ProtectedPVCAnnotations = []string{pvcEncryptionAnnotation}
 	configMapCreate(ramenConfig)
 
 	s3Secrets[0] = corev1.Secret{
@@ -343,7 +344,11 @@ var _ = BeforeSuite(func() {
 		Scheme:            k8sManager.GetScheme(),
 		Log:               ctrl.Log.WithName("controllers").WithName("DRPolicy"),
 		ObjectStoreGetter: fakeObjectStoreGetter{},
-		RateLimiter:       &rateLimiter,
+		MCVGetter: FakeMCVGetter{
+			Client:    k8sClient,
+			apiReader: k8sManager.GetAPIReader(),
+		},
+		RateLimiter: &rateLimiter,
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
 	err = (&ramencontrollers.VolumeReplicationGroupReconciler{
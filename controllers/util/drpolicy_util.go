@@ -56,6 +56,10 @@ func DrpolicyValidated(drpolicy *rmn.DRPolicy) error {
 			return errors.New(condition.Message)
 		}
 
+		if drpolicy.Spec.ReportOnly {
+			return errors.New(`drpolicy is in report-only mode`)
+		}
+
 		return nil
 	}
 
@@ -90,9 +94,14 @@ func DRPolicyS3Profiles(drpolicy *rmn.DRPolicy, drclusters []rmn.DRCluster) sets
 	return mustHaveS3Profiles
 }
 
-//nolint:gomnd
 func GetSecondsFromSchedulingInterval(drpolicy *rmn.DRPolicy) (float64, error) {
-	schedulingInterval := drpolicy.Spec.SchedulingInterval
+	return SchedulingIntervalSeconds(drpolicy.Spec.SchedulingInterval)
+}
+
+// SchedulingIntervalSeconds converts a scheduling interval in the <num><m,h,d> format to seconds
+//
+//nolint:gomnd
+func SchedulingIntervalSeconds(schedulingInterval string) (float64, error) {
 	if schedulingInterval == "" {
 		return 0, nil
 	}
@@ -55,6 +55,10 @@ const (
 	// EventReasonSecondarySuccess is an event generated when VRG is successfully
 	// processed as Primary.
 	EventReasonDeleteSuccess = "VRGDeleteSuccess"
+
+	// EventReasonVolSyncDestinationPVCMissing is generated when the destination PVC of an active VolSync
+	// ReplicationDestination is found missing, and its recreation is re-driven
+	EventReasonVolSyncDestinationPVCMissing = "VolSyncDestinationPVCMissing"
 	// TODO: Add any additional events (or remove one of existing ones above) if necessary.
 
 	// Events for DRPC Reconciler
@@ -90,6 +94,25 @@ const (
 	// EventReasonSwitchFailed is generated when DRPC fails to switch the cluster
 	// where the app is placed
 	EventReasonSwitchFailed = "DRPCClusterSwitchFailed"
+
+	// Events for DRPolicy Reconciler
+
+	// EventReasonDRPolicyValidated is generated when DRPolicy transitions to validated
+	EventReasonDRPolicyValidated = "DRPolicyValidated"
+
+	// EventReasonDRPolicyValidationFailed is generated when DRPolicy transitions to not validated
+	EventReasonDRPolicyValidationFailed = "DRPolicyValidationFailed"
+
+	// EventReasonDRPolicyConflict is generated when DRPolicy conflicts with another DRPolicy
+	EventReasonDRPolicyConflict = "DRPolicyConflict"
+
+	// EventReasonSecretPropagated is generated when DRPolicy successfully propagates the s3 secrets
+	// to its clusters
+	EventReasonSecretPropagated = "SecretPropagated"
+
+	// EventReasonSecretPropagationFailed is generated when DRPolicy fails to propagate the s3 secrets
+	// to its clusters
+	EventReasonSecretPropagationFailed = "SecretPropagationFailed"
 )
 
 // EventReporter is custom events reporter type which allows user to limit the events
@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	errorswrapper "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -47,6 +48,9 @@ type ManagedClusterViewGetter interface {
 	GetNamespaceFromManagedCluster(resourceName, resourceNamespace, managedCluster string,
 		annotations map[string]string) (*corev1.Namespace, error)
 
+	GetCRDFromManagedCluster(crdName, managedCluster string,
+		annotations map[string]string) (*apiextensionsv1.CustomResourceDefinition, error)
+
 	DeleteVRGManagedClusterView(resourceName, resourceNamespace, clusterName, resourceType string) error
 
 	DeleteNamespaceManagedClusterView(resourceName, resourceNamespace, clusterName, resourceType string) error
@@ -210,6 +214,36 @@ func (m ManagedClusterViewGetterImpl) GetNamespaceFromManagedCluster(
 	return namespace, err
 }
 
+// GetCRDFromManagedCluster gets the named CustomResourceDefinition of the managed cluster, e.g. to read the version
+// of the operator installing it from its labels
+func (m ManagedClusterViewGetterImpl) GetCRDFromManagedCluster(crdName, managedCluster string,
+	annotations map[string]string,
+) (*apiextensionsv1.CustomResourceDefinition, error) {
+	logger := ctrl.Log.WithName("MCV").WithValues("resourceName", crdName, "cluster", managedCluster)
+
+	mcvMeta := metav1.ObjectMeta{
+		Name:      BuildManagedClusterViewName(crdName, "", MWTypeCRD),
+		Namespace: managedCluster,
+	}
+
+	if annotations != nil {
+		mcvMeta.Annotations = annotations
+	}
+
+	mcvViewscope := viewv1beta1.ViewScope{
+		Kind:    "CustomResourceDefinition",
+		Group:   apiextensionsv1.SchemeGroupVersion.Group,
+		Version: apiextensionsv1.SchemeGroupVersion.Version,
+		Name:    crdName,
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+
+	err := m.getManagedClusterResource(mcvMeta, mcvViewscope, crd, logger)
+
+	return crd, err
+}
+
 /*
 Description: queries a managed cluster for a resource type, and populates a variable with the results.
 Requires:
@@ -44,6 +44,14 @@ func (u *ResourceUpdater) AddLabel(key, value string) *ResourceUpdater {
 	return u
 }
 
+func (u *ResourceUpdater) AddAnnotation(key, value string) *ResourceUpdater {
+	added := AddAnnotation(u.obj, key, value)
+
+	u.objModified = u.objModified || added
+
+	return u
+}
+
 func (u *ResourceUpdater) AddFinalizer(finalizerName string) *ResourceUpdater {
 	added := AddFinalizer(u.obj, finalizerName)
 
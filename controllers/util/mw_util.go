@@ -44,6 +44,7 @@ const (
 	MWTypeNS    string = "ns"
 	MWTypeNF    string = "nf"
 	MWTypeMMode string = "mmode"
+	MWTypeCRD   string = "crd"
 )
 
 type MWUtil struct {
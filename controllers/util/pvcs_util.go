@@ -5,9 +5,11 @@ package util
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-logr/logr"
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
 	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -27,6 +29,9 @@ const (
 	VolumeAttachmentToPVIndexName string = "spec.source.persistentVolumeName"
 )
 
+// ErrPVCDeletionPending is returned when a deleted PVC still exists, typically held by a finalizer
+var ErrPVCDeletionPending = errors.New("pvc deletion pending")
+
 func ListPVCsByPVCSelector(
 	ctx context.Context,
 	k8sClient client.Client,
@@ -98,6 +103,20 @@ func IsPVCInUseByPod(ctx context.Context,
 	pvcNamespacedName types.NamespacedName,
 	inUsePodMustBeReady bool,
 ) (bool, error) {
+	inUse, _, err := IsPVCInUseByNonExcludedPod(ctx, k8sClient, log, pvcNamespacedName, inUsePodMustBeReady, nil)
+
+	return inUse, err
+}
+
+// IsPVCInUseByNonExcludedPod is IsPVCInUseByPod, but disregards pods matching any of the exclusions. It
+// additionally returns true if the PVC is mounted by at least one excluded pod.
+func IsPVCInUseByNonExcludedPod(ctx context.Context,
+	k8sClient client.Client,
+	log logr.Logger,
+	pvcNamespacedName types.NamespacedName,
+	inUsePodMustBeReady bool,
+	exclusions []rmn.PodExclusion,
+) (bool, bool, error) {
 	log = log.WithValues("pvc", pvcNamespacedName.String())
 	podUsingPVCList := &corev1.PodList{}
 
@@ -108,17 +127,30 @@ func IsPVCInUseByPod(ctx context.Context,
 	if err != nil {
 		log.Error(err, "unable to lookup pods to see if they are using pvc")
 
-		return false, fmt.Errorf("unable to lookup pods to check if pvc is in use (%w)", err)
-	}
-
-	if len(podUsingPVCList.Items) == 0 {
-		return false /* Not in use by any pod */, nil
+		return false, false, fmt.Errorf("unable to lookup pods to check if pvc is in use (%w)", err)
 	}
 
 	mountingPodIsReady := false
+	mountedByExcludedPod := false
 
 	inUsePods := []string{}
-	for _, pod := range podUsingPVCList.Items {
+
+	for i := range podUsingPVCList.Items {
+		pod := &podUsingPVCList.Items[i]
+
+		excluded, err := PodMatchesExclusions(pod, exclusions)
+		if err != nil {
+			return false, false, err
+		}
+
+		if excluded {
+			log.V(1).Info("Ignoring pod excluded from in-use check", "pod", pod.GetName())
+
+			mountedByExcludedPod = true
+
+			continue
+		}
+
 		inUsePods = append(inUsePods, fmt.Sprintf("pod: %s, phase: %s", pod.GetName(), pod.Status.Phase))
 
 		if pod.Status.Phase == corev1.PodRunning {
@@ -128,13 +160,46 @@ func IsPVCInUseByPod(ctx context.Context,
 		}
 	}
 
+	if len(inUsePods) == 0 {
+		return false /* Not in use by any pod */, mountedByExcludedPod, nil
+	}
+
 	log.Info("pvc is in use by pod(s)", "pods", inUsePods)
 
 	if inUsePodMustBeReady {
-		return mountingPodIsReady, nil
+		return mountingPodIsReady, mountedByExcludedPod, nil
 	}
 
-	return true, nil
+	return true, mountedByExcludedPod, nil
+}
+
+// PodMatchesExclusions returns true if the pod matches any of the exclusions. An exclusion with neither a
+// namespace nor a pod selector matches no pod.
+func PodMatchesExclusions(pod *corev1.Pod, exclusions []rmn.PodExclusion) (bool, error) {
+	for i := range exclusions {
+		exclusion := &exclusions[i]
+
+		selectorEmpty := len(exclusion.PodSelector.MatchLabels) == 0 &&
+			len(exclusion.PodSelector.MatchExpressions) == 0
+		if exclusion.Namespace == "" && selectorEmpty {
+			continue
+		}
+
+		if exclusion.Namespace != "" && exclusion.Namespace != pod.GetNamespace() {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&exclusion.PodSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid in-use check exclusion pod selector (%w)", err)
+		}
+
+		if selector.Matches(labels.Set(pod.GetLabels())) {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
 
 // For CSI drivers that support it, volume attachments will be created for the PV to indicate which node
@@ -255,3 +320,32 @@ func DeletePVC(ctx context.Context,
 
 	return nil
 }
+
+// DeletePVCAndVerify is DeletePVC, but additionally verifies that the PVC is gone. Returns an error wrapping
+// ErrPVCDeletionPending, naming the finalizers blocking its deletion, if the PVC still exists.
+func DeletePVCAndVerify(ctx context.Context,
+	k8sClient client.Client,
+	pvcName, namespace string,
+	log logr.Logger,
+) error {
+	if err := DeletePVC(ctx, k8sClient, pvcName, namespace, log); err != nil {
+		return err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: namespace}, pvc)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error verifying pvc deletion (%w)", err)
+	}
+
+	pendingErr := fmt.Errorf("%w, pvc: %s, blocked by finalizers %v", ErrPVCDeletionPending, pvcName,
+		pvc.GetFinalizers())
+	log.Info("pvc deletion pending", "pvcName", pvcName, "finalizers", pvc.GetFinalizers())
+
+	return pendingErr
+}
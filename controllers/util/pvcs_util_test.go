@@ -10,11 +10,13 @@ import (
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/controllers/util"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("PVCS_Util", func() {
@@ -225,6 +227,95 @@ var _ = Describe("PVCS_Util", func() {
 			})
 		})
 	})
+
+	Describe("Delete PVC and verify", func() {
+		var pvc *corev1.PersistentVolumeClaim
+
+		foreignFinalizer := "example.com/foreign-finalizer"
+
+		BeforeEach(func() {
+			pvc = createTestPVC(testCtx, testNamespace.GetName(), map[string]string{})
+			pvc.SetFinalizers(append(pvc.GetFinalizers(), foreignFinalizer))
+			Expect(k8sClient.Update(testCtx, pvc)).To(Succeed())
+		})
+
+		It("Should report the deletion pending while the PVC is held by a foreign finalizer", func() {
+			err := util.DeletePVCAndVerify(testCtx, k8sClient, pvc.GetName(), pvc.GetNamespace(), testLogger)
+			Expect(err).To(MatchError(util.ErrPVCDeletionPending))
+			Expect(err.Error()).To(ContainSubstring(foreignFinalizer))
+
+			// A plain delete does not report the lingering PVC
+			Expect(util.DeletePVC(testCtx, k8sClient, pvc.GetName(), pvc.GetNamespace(), testLogger)).To(Succeed())
+		})
+
+		It("Should succeed once the PVC is gone", func() {
+			Expect(k8sClient.Get(testCtx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+			pvc.SetFinalizers(nil)
+			Expect(k8sClient.Update(testCtx, pvc)).To(Succeed())
+
+			Expect(util.DeletePVCAndVerify(testCtx, k8sClient, pvc.GetName(), pvc.GetNamespace(),
+				testLogger)).To(Succeed())
+		})
+	})
+
+	Describe("Match pods against in-use check exclusions", func() {
+		infraPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "csi-rbdplugin-abcde",
+				Namespace: "rook-ceph",
+				Labels:    map[string]string{"app": "csi-rbdplugin"},
+			},
+		}
+		appPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "busybox",
+				Namespace: "app-ns",
+				Labels:    map[string]string{"app": "busybox"},
+			},
+		}
+
+		It("Should not match any pod when there are no exclusions", func() {
+			Expect(util.PodMatchesExclusions(infraPod, nil)).To(BeFalse())
+		})
+
+		It("Should not match any pod with an empty exclusion", func() {
+			Expect(util.PodMatchesExclusions(appPod, []rmn.PodExclusion{{}})).To(BeFalse())
+		})
+
+		It("Should match all pods in an excluded namespace", func() {
+			exclusions := []rmn.PodExclusion{{Namespace: "rook-ceph"}}
+			Expect(util.PodMatchesExclusions(infraPod, exclusions)).To(BeTrue())
+			Expect(util.PodMatchesExclusions(appPod, exclusions)).To(BeFalse())
+		})
+
+		It("Should match pods by label in any namespace", func() {
+			exclusions := []rmn.PodExclusion{{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "csi-rbdplugin"}},
+			}}
+			Expect(util.PodMatchesExclusions(infraPod, exclusions)).To(BeTrue())
+			Expect(util.PodMatchesExclusions(appPod, exclusions)).To(BeFalse())
+		})
+
+		It("Should require both the namespace and the labels to match when both are set", func() {
+			exclusions := []rmn.PodExclusion{{
+				Namespace:   "app-ns",
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "csi-rbdplugin"}},
+			}}
+			Expect(util.PodMatchesExclusions(infraPod, exclusions)).To(BeFalse())
+			Expect(util.PodMatchesExclusions(appPod, exclusions)).To(BeFalse())
+		})
+
+		It("Should return an error for an invalid selector", func() {
+			exclusions := []rmn.PodExclusion{{
+				PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "app",
+					Operator: "BadOperator",
+				}}},
+			}}
+			_, err := util.PodMatchesExclusions(infraPod, exclusions)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 func createTestPVC(ctx context.Context, namespace string, labels map[string]string) *corev1.PersistentVolumeClaim {
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"errors"
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	ReplicationSourceCRDName      = "replicationsources.volsync.backube"
+	ReplicationDestinationCRDName = "replicationdestinations.volsync.backube"
+
+	// VolSyncVersionLabel is read from the VolSync CRDs to report the installed VolSync version
+	VolSyncVersionLabel = "app.kubernetes.io/version"
+)
+
+// ErrCapabilityNotSupported is returned when a feature is requested that the installed VolSync does not support
+var ErrCapabilityNotSupported = errors.New("not supported by the installed VolSync")
+
+// Capabilities are the features of the VolSync installed on the cluster, as detected from its CRDs
+type Capabilities struct {
+	// Detected is false if the capabilities could not be detected, in which case the remaining fields
+	// are set to BaselineCapabilities
+	Detected bool
+	// Version is the VolSync version, if labeled on its CRDs
+	Version string
+	// Pause is true if ReplicationSources and ReplicationDestinations can be paused
+	Pause bool
+	// DirectCopy is true if ReplicationDestinations support the Direct copy method
+	DirectCopy bool
+	// Retention is true if ReplicationSources support a retain policy for their snapshots
+	Retention bool
+}
+
+// BaselineCapabilities are those of VolSync v0.7.1, the oldest version Ramen supports, assumed when the capabilities
+// of the installed VolSync cannot be detected
+var BaselineCapabilities = Capabilities{
+	Pause:      true,
+	DirectCopy: true,
+	Retention:  true,
+}
+
+// Capabilities returns the capabilities of the installed VolSync, detecting them on first use
+func (v *VSHandler) Capabilities() Capabilities {
+	if v.capabilities == nil {
+		capabilities := v.detectCapabilities()
+		v.capabilities = &capabilities
+	}
+
+	return *v.capabilities
+}
+
+func (v *VSHandler) detectCapabilities() Capabilities {
+	rdCRD, rdSpec, err := v.getCRDSpecSchema(ReplicationDestinationCRDName)
+	if err != nil {
+		v.log.Info("Unable to detect VolSync capabilities, assuming baseline capabilities", "error", err)
+
+		return BaselineCapabilities
+	}
+
+	_, rsSpec, err := v.getCRDSpecSchema(ReplicationSourceCRDName)
+	if err != nil {
+		v.log.Info("Unable to detect VolSync capabilities, assuming baseline capabilities", "error", err)
+
+		return BaselineCapabilities
+	}
+
+	_, rdPause := rdSpec.Properties["paused"]
+	_, rsPause := rsSpec.Properties["paused"]
+	_, retention := rsSpec.Properties["restic"].Properties["retain"]
+
+	capabilities := Capabilities{
+		Detected: true,
+		Version:  rdCRD.GetLabels()[VolSyncVersionLabel],
+		Pause:    rdPause && rsPause,
+		DirectCopy: schemaEnumContains(rdSpec.Properties["rsyncTLS"].Properties["copyMethod"],
+			string(volsyncv1alpha1.CopyMethodDirect)),
+		Retention: retention,
+	}
+
+	v.log.Info("Detected VolSync capabilities", "capabilities", capabilities)
+
+	return capabilities
+}
+
+// getCRDSpecSchema returns the named CRD, and the schema of the spec of the VolSync API version in use
+func (v *VSHandler) getCRDSpecSchema(crdName string) (
+	*apiextensionsv1.CustomResourceDefinition, apiextensionsv1.JSONSchemaProps, error,
+) {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: crdName}, crd); err != nil {
+		return nil, apiextensionsv1.JSONSchemaProps{}, fmt.Errorf("error getting CRD %s (%w)", crdName, err)
+	}
+
+	for _, crdVersion := range crd.Spec.Versions {
+		if crdVersion.Name != volsyncv1alpha1.GroupVersion.Version ||
+			crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		if spec, ok := crdVersion.Schema.OpenAPIV3Schema.Properties["spec"]; ok {
+			return crd, spec, nil
+		}
+	}
+
+	return nil, apiextensionsv1.JSONSchemaProps{}, fmt.Errorf("no spec schema in CRD %s for version %s", crdName,
+		volsyncv1alpha1.GroupVersion.Version)
+}
+
+func schemaEnumContains(schema apiextensionsv1.JSONSchemaProps, value string) bool {
+	for _, enumValue := range schema.Enum {
+		if string(enumValue.Raw) == `"`+value+`"` {
+			return true
+		}
+	}
+
+	return false
+}
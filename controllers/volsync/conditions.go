@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// ConditionTypeReplicationReady is the type of the condition reporting the readiness of the ReplicationSource, or
+// ReplicationDestination, of a protected PVC
+const ConditionTypeReplicationReady = "VolSyncReplicationReady"
+
+// Reasons of the ConditionTypeReplicationReady condition
+const (
+	// ConditionReasonReplicationReady is set when the ReplicationSource has synced, or the ReplicationDestination is
+	// ready to receive syncs, and the latest sync did not fail
+	ConditionReasonReplicationReady = "Ready"
+
+	// ConditionReasonReplicationProgressing is set while the ReplicationSource or ReplicationDestination does not
+	// exist, or has not completed its setup or initial sync
+	ConditionReasonReplicationProgressing = "Progressing"
+
+	// ConditionReasonReplicationPaused is set when the ReplicationSource or ReplicationDestination is paused
+	ConditionReasonReplicationPaused = "Paused"
+
+	// ConditionReasonReplicationSyncFailed is set when VolSync reports the mover of the latest sync failed
+	ConditionReasonReplicationSyncFailed = "SyncFailed"
+
+	// ConditionReasonReplicationError is set when VolSync reports an error reconciling the ReplicationSource or
+	// ReplicationDestination
+	ConditionReasonReplicationError = "Error"
+)
+
+// ProtectedPVCCondition is the ConditionTypeReplicationReady condition of a protected PVC
+type ProtectedPVCCondition struct {
+	Namespace string
+	Name      string
+	Condition metav1.Condition
+}
+
+// ReplicationConditions returns the ConditionTypeReplicationReady condition of each of the protected PVCs, in their
+// order, ready to be set on the conditions of the PVCs in the VRG status. The condition reflects the
+// ReplicationSource of the PVC owned by the owner, or its ReplicationDestination if it has no ReplicationSource, as
+// VolSync reports them.
+func (v *VSHandler) ReplicationConditions(protectedPVCs []ramendrv1alpha1.ProtectedPVC, observedGeneration int64,
+) ([]ProtectedPVCCondition, error) {
+	conditions := make([]ProtectedPVCCondition, 0, len(protectedPVCs))
+
+	for i := range protectedPVCs {
+		pvcName, pvcNamespace := protectedPVCs[i].Name, protectedPVCs[i].Namespace
+
+		condition, err := v.replicationCondition(pvcName, pvcNamespace)
+		if err != nil {
+			return nil, err
+		}
+
+		condition.Type = ConditionTypeReplicationReady
+		condition.ObservedGeneration = observedGeneration
+
+		conditions = append(conditions, ProtectedPVCCondition{
+			Namespace: pvcNamespace,
+			Name:      pvcName,
+			Condition: condition,
+		})
+	}
+
+	return conditions, nil
+}
+
+func (v *VSHandler) replicationCondition(pvcName, pvcNamespace string) (metav1.Condition, error) {
+	rs := &volsyncv1alpha1.ReplicationSource{}
+
+	found, err := v.getOwned(getReplicationSourceName(pvcName), pvcNamespace, rs)
+	if err != nil {
+		return metav1.Condition{}, err
+	}
+
+	if found {
+		return rsReplicationCondition(rs), nil
+	}
+
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+
+	found, err = v.getOwned(getReplicationDestinationName(pvcName), pvcNamespace, rd)
+	if err != nil {
+		return metav1.Condition{}, err
+	}
+
+	if found {
+		return rdReplicationCondition(rd), nil
+	}
+
+	return metav1.Condition{
+		Status:  metav1.ConditionFalse,
+		Reason:  ConditionReasonReplicationProgressing,
+		Message: "ReplicationSource or ReplicationDestination not found",
+	}, nil
+}
+
+// getOwned gets the object, returning false if it does not exist or is not owned by the owner
+func (v *VSHandler) getOwned(name, namespace string, obj client.Object) (bool, error) {
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get %s/%s (%w)", namespace, name, err)
+	}
+
+	return util.HasLabelWithValue(obj, VRGOwnerNameLabel, v.owner.GetName()) &&
+		util.HasLabelWithValue(obj, VRGOwnerNamespaceLabel, v.owner.GetNamespace()), nil
+}
+
+func rsReplicationCondition(rs *volsyncv1alpha1.ReplicationSource) metav1.Condition {
+	if rs.Spec.Paused {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationPaused,
+			Message: "ReplicationSource is paused",
+		}
+	}
+
+	if rs.Status == nil {
+		return replicationStatusCondition("ReplicationSource", nil, nil, false)
+	}
+
+	return replicationStatusCondition("ReplicationSource", rs.Status.Conditions, rs.Status.LatestMoverStatus,
+		rs.Status.LastSyncTime != nil)
+}
+
+func rdReplicationCondition(rd *volsyncv1alpha1.ReplicationDestination) metav1.Condition {
+	if rd.Spec.Paused {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationPaused,
+			Message: "ReplicationDestination is paused",
+		}
+	}
+
+	if rd.Status == nil || rd.Status.RsyncTLS == nil || rd.Status.RsyncTLS.Address == nil {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationProgressing,
+			Message: "ReplicationDestination has no address yet",
+		}
+	}
+
+	// A ReplicationDestination is ready to receive syncs once it has an address, before it completes any sync
+	return replicationStatusCondition("ReplicationDestination", rd.Status.Conditions, rd.Status.LatestMoverStatus,
+		true)
+}
+
+// replicationStatusCondition returns the condition of a ReplicationSource or ReplicationDestination from its status,
+// failures reported by VolSync taking precedence over its readiness
+func replicationStatusCondition(kind string, conditions []metav1.Condition,
+	latestMoverStatus *volsyncv1alpha1.MoverStatus, ready bool,
+) metav1.Condition {
+	synchronizing := meta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if synchronizing != nil && synchronizing.Reason == volsyncv1alpha1.SynchronizingReasonError {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationError,
+			Message: fmt.Sprintf("%s error: %s", kind, synchronizing.Message),
+		}
+	}
+
+	if latestMoverStatus != nil && latestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationSyncFailed,
+			Message: fmt.Sprintf("%s latest sync failed", kind),
+		}
+	}
+
+	if !ready {
+		return metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonReplicationProgressing,
+			Message: fmt.Sprintf("%s initial sync has not completed", kind),
+		}
+	}
+
+	return metav1.Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonReplicationReady,
+		Message: fmt.Sprintf("%s is ready", kind),
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// rsyncTLSDestinationJobPrefix prefixes the name of the job VolSync runs the rsync-tls destination mover of a
+	// ReplicationDestination in, the job being named after the ReplicationDestination
+	rsyncTLSDestinationJobPrefix = "volsync-rsync-tls-dst-"
+
+	// destinationPVCPrefix and destinationPVCSuffix surround the name of the ReplicationDestination in the name of
+	// the destination PVC VolSync creates for it
+	destinationPVCPrefix = "volsync-"
+	destinationPVCSuffix = "-dst"
+)
+
+// RecoverMissingDestinationPVC re-drives the creation of the destination PVC of the ReplicationDestination, if the
+// PVC was deleted, or is being deleted, while the ReplicationDestination is active, i.e. once it has a latestImage.
+// VolSync recreates a missing destination PVC when the mover restarts, which this forces by deleting the mover job,
+// releasing a PVC the mover still holds. Returns true if the destination PVC was missing.
+func (v *VSHandler) RecoverMissingDestinationPVC(rd *volsyncv1alpha1.ReplicationDestination) (bool, error) {
+	if rd == nil || rd.Spec.Paused || rd.Spec.RsyncTLS == nil || rd.Status == nil ||
+		!isLatestImageReady(rd.Status.LatestImage) {
+		return false, nil
+	}
+
+	pvcName := getDestinationPVCName(rd)
+
+	pvc, err := v.getPVC(types.NamespacedName{Name: pvcName, Namespace: rd.GetNamespace()})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return false, err
+	}
+
+	if err == nil && pvc.GetDeletionTimestamp().IsZero() {
+		return false, nil
+	}
+
+	v.log.Info("Destination PVC of active ReplicationDestination is missing, restarting its mover",
+		"rdName", rd.GetName(), "pvcName", pvcName, "latestImage", rd.Status.LatestImage.Name)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rsyncTLSDestinationJobPrefix + rd.GetName(),
+			Namespace: rd.GetNamespace(),
+		},
+	}
+
+	err = v.client.Delete(v.ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !kerrors.IsNotFound(err) {
+		return true, fmt.Errorf("failed to delete mover job %s/%s (%w)", job.GetNamespace(), job.GetName(), err)
+	}
+
+	return true, nil
+}
+
+// getDestinationPVCName returns the name of the PVC the ReplicationDestination replicates to, the PVC set in its spec
+// or otherwise the PVC VolSync creates for it
+func getDestinationPVCName(rd *volsyncv1alpha1.ReplicationDestination) string {
+	if rd.Spec.RsyncTLS.DestinationPVC != nil && *rd.Spec.RsyncTLS.DestinationPVC != "" {
+		return *rd.Spec.RsyncTLS.DestinationPVC
+	}
+
+	return destinationPVCPrefix + rd.GetName() + destinationPVCSuffix
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DriftFieldSchedule            = "trigger.schedule"
+	DriftFieldCopyMethod          = "rsyncTLS.copyMethod"
+	DriftFieldCapacity            = "rsyncTLS.capacity"
+	DriftFieldVolumeSnapshotClass = "rsyncTLS.volumeSnapshotClassName"
+)
+
+// FieldDrift is a field managed by Ramen whose value differs from the value Ramen sets
+type FieldDrift struct {
+	Field   string
+	Desired string
+	Actual  string
+}
+
+// DriftReport lists the drifted fields of a ReplicationSource or ReplicationDestination owned by the VRG
+type DriftReport struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Fields    []FieldDrift
+}
+
+// DetectDrift compares the fields managed by Ramen of the ReplicationSources and ReplicationDestinations owned by
+// the VRG, i.e. the trigger schedule, copy method, capacity and volume snapshot class, against the values Ramen
+// would set, and returns a report for each object that drifted, e.g. after a manual edit. ReplicationDestinations
+// are compared against their entry in rdSpecs, and are skipped if they have none. Local objects used for direct
+// copy are skipped.
+func (v *VSHandler) DetectDrift(rdSpecs []ramendrv1alpha1.VolSyncReplicationDestinationSpec) ([]DriftReport, error) {
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	rdList, err := v.listRDByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	localNames := map[types.NamespacedName]bool{}
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+		localNames[types.NamespacedName{Name: getLocalReplicationName(rd.GetName()), Namespace: rd.GetNamespace()}] = true
+	}
+
+	reports := []DriftReport{}
+
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		if localNames[client.ObjectKeyFromObject(rs)] {
+			continue
+		}
+
+		fields, err := v.detectRSDrift(rs)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			reports = append(reports, DriftReport{
+				Kind: "ReplicationSource", Name: rs.GetName(), Namespace: rs.GetNamespace(), Fields: fields,
+			})
+		}
+	}
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+		if localNames[client.ObjectKeyFromObject(rd)] {
+			continue
+		}
+
+		rdSpec := findRDSpec(rdSpecs, rd)
+		if rdSpec == nil {
+			continue
+		}
+
+		fields, err := v.detectRDDrift(rd, *rdSpec)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			reports = append(reports, DriftReport{
+				Kind: "ReplicationDestination", Name: rd.GetName(), Namespace: rd.GetNamespace(), Fields: fields,
+			})
+		}
+	}
+
+	return reports, nil
+}
+
+func (v *VSHandler) detectRSDrift(rs *volsyncv1alpha1.ReplicationSource) ([]FieldDrift, error) {
+	fields := []FieldDrift{}
+
+	// The schedule is replaced by a manual trigger while running the initial or final sync
+	if rs.Spec.Trigger == nil || rs.Spec.Trigger.Manual == "" {
+		schedule, err := v.getScheduleCronSpec(rs.Spec.SourcePVC)
+		if err != nil {
+			return nil, err
+		}
+
+		var actual *string
+		if rs.Spec.Trigger != nil {
+			actual = rs.Spec.Trigger.Schedule
+		}
+
+		fields = appendFieldDrift(fields, DriftFieldSchedule, *schedule, stringValue(actual))
+	}
+
+	var options volsyncv1alpha1.ReplicationSourceVolumeOptions
+	if rs.Spec.RsyncTLS != nil {
+		options = rs.Spec.RsyncTLS.ReplicationSourceVolumeOptions
+	}
+
+	fields = appendFieldDrift(fields, DriftFieldCopyMethod, string(volsyncv1alpha1.CopyMethodSnapshot),
+		string(options.CopyMethod))
+
+	// The volume snapshot class is selected using the storage class of the source PVC
+	pvc, err := v.getPVC(types.NamespacedName{Name: rs.Spec.SourcePVC, Namespace: rs.GetNamespace()})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return fields, nil
+		}
+
+		return nil, fmt.Errorf("error getting source pvc of ReplicationSource %s (%w)", rs.GetName(), err)
+	}
+
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(pvc.Spec.StorageClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendFieldDrift(fields, DriftFieldVolumeSnapshotClass, volumeSnapshotClassName,
+		stringValue(options.VolumeSnapshotClassName)), nil
+}
+
+func (v *VSHandler) detectRDDrift(rd *volsyncv1alpha1.ReplicationDestination,
+	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+) ([]FieldDrift, error) {
+	var options volsyncv1alpha1.ReplicationDestinationVolumeOptions
+	if rd.Spec.RsyncTLS != nil {
+		options = rd.Spec.RsyncTLS.ReplicationDestinationVolumeOptions
+	}
+
+	fields := appendFieldDrift([]FieldDrift{}, DriftFieldCopyMethod, string(volsyncv1alpha1.CopyMethodSnapshot),
+		string(options.CopyMethod))
+
+	desiredCapacity := rdSpec.ProtectedPVC.Resources.Requests.Storage()
+	if options.Capacity == nil || options.Capacity.Cmp(*desiredCapacity) != 0 {
+		actualCapacity := ""
+		if options.Capacity != nil {
+			actualCapacity = options.Capacity.String()
+		}
+
+		fields = append(fields, FieldDrift{
+			Field: DriftFieldCapacity, Desired: desiredCapacity.String(), Actual: actualCapacity,
+		})
+	}
+
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(rdSpec.ProtectedPVC.StorageClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	return appendFieldDrift(fields, DriftFieldVolumeSnapshotClass, volumeSnapshotClassName,
+		stringValue(options.VolumeSnapshotClassName)), nil
+}
+
+func findRDSpec(rdSpecs []ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+	rd *volsyncv1alpha1.ReplicationDestination,
+) *ramendrv1alpha1.VolSyncReplicationDestinationSpec {
+	for i := range rdSpecs {
+		if getReplicationDestinationName(rdSpecs[i].ProtectedPVC.Name) == rd.GetName() &&
+			rdSpecs[i].ProtectedPVC.Namespace == rd.GetNamespace() {
+			return &rdSpecs[i]
+		}
+	}
+
+	return nil
+}
+
+func appendFieldDrift(fields []FieldDrift, field, desired, actual string) []FieldDrift {
+	if desired == actual {
+		return fields
+	}
+
+	return append(fields, FieldDrift{Field: field, Desired: desired, Actual: actual})
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fieldManagerClient sets the field manager of the VSHandler on every create, update and patch, including of status
+// subresources, so that the managed fields of the objects it writes are attributable to Ramen and distinguishable
+// from user edits. A field owner passed by the caller takes precedence.
+type fieldManagerClient struct {
+	client.Client
+	fieldManager string
+}
+
+func newFieldManagerClient(c client.Client, fieldManager string) client.Client {
+	return &fieldManagerClient{Client: c, fieldManager: fieldManager}
+}
+
+func (c *fieldManagerClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.Client.Create(ctx, obj, append([]client.CreateOption{client.FieldOwner(c.fieldManager)}, opts...)...)
+}
+
+func (c *fieldManagerClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.Client.Update(ctx, obj, append([]client.UpdateOption{client.FieldOwner(c.fieldManager)}, opts...)...)
+}
+
+func (c *fieldManagerClient) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption,
+) error {
+	return c.Client.Patch(ctx, obj, patch,
+		append([]client.PatchOption{client.FieldOwner(c.fieldManager)}, opts...)...)
+}
+
+func (c *fieldManagerClient) Status() client.SubResourceWriter {
+	return &fieldManagerSubResourceWriter{SubResourceWriter: c.Client.Status(), fieldManager: c.fieldManager}
+}
+
+type fieldManagerSubResourceWriter struct {
+	client.SubResourceWriter
+	fieldManager string
+}
+
+func (w *fieldManagerSubResourceWriter) Create(ctx context.Context, obj client.Object, subResource client.Object,
+	opts ...client.SubResourceCreateOption,
+) error {
+	return w.SubResourceWriter.Create(ctx, obj, subResource,
+		append([]client.SubResourceCreateOption{client.FieldOwner(w.fieldManager)}, opts...)...)
+}
+
+func (w *fieldManagerSubResourceWriter) Update(ctx context.Context, obj client.Object,
+	opts ...client.SubResourceUpdateOption,
+) error {
+	return w.SubResourceWriter.Update(ctx, obj,
+		append([]client.SubResourceUpdateOption{client.FieldOwner(w.fieldManager)}, opts...)...)
+}
+
+func (w *fieldManagerSubResourceWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.SubResourcePatchOption,
+) error {
+	return w.SubResourceWriter.Patch(ctx, obj, patch,
+		append([]client.SubResourcePatchOption{client.FieldOwner(w.fieldManager)}, opts...)...)
+}
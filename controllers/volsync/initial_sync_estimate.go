@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// DefaultInitialSyncBytesPerSecond is the throughput assumed to estimate the duration of an initial sync when no
+// sync of the owner has reported its throughput, unless configured otherwise
+const DefaultInitialSyncBytesPerSecond = 50 * 1024 * 1024
+
+// InitialSyncEstimate is the best-effort estimate of the duration of the initial sync of a PVC
+type InitialSyncEstimate struct {
+	// Duration of the initial sync, transferring the requested storage of the PVC at BytesPerSecond
+	Duration time.Duration
+	// BytesPerSecond is the throughput the estimate assumes
+	BytesPerSecond float64
+	// FromHistory is true if BytesPerSecond was observed by prior syncs of the owner, false if it is the configured
+	// default
+	FromHistory bool
+}
+
+// EstimateInitialSync estimates the duration of the initial sync of the protected PVC from its requested storage, an
+// upper bound of the data the sync transfers, and the mean throughput of the most recent syncs of the
+// ReplicationSources of the owner that report it. If none does, e.g. as the PVC is the first one the owner protects,
+// the configured default throughput is assumed.
+func (v *VSHandler) EstimateInitialSync(protectedPVC ramendrv1alpha1.ProtectedPVC) (InitialSyncEstimate, error) {
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return InitialSyncEstimate{}, err
+	}
+
+	estimate := InitialSyncEstimate{BytesPerSecond: v.initialSyncBytesPerSecond()}
+
+	observed := 0
+	totalBytesPerSecond := 0.0
+
+	for i := range rsList.Items {
+		stats := syncStatsFromStatus(rsList.Items[i].Status)
+		if !stats.Available || stats.BytesPerSecond <= 0 {
+			continue
+		}
+
+		observed++
+		totalBytesPerSecond += stats.BytesPerSecond
+	}
+
+	if observed > 0 {
+		estimate.BytesPerSecond = totalBytesPerSecond / float64(observed)
+		estimate.FromHistory = true
+	}
+
+	size := protectedPVC.Resources.Requests.Storage().Value()
+	if estimate.BytesPerSecond > 0 {
+		estimate.Duration = time.Duration(float64(size) / estimate.BytesPerSecond * float64(time.Second)).
+			Round(time.Second)
+	}
+
+	return estimate, nil
+}
+
+func (v *VSHandler) initialSyncBytesPerSecond() float64 {
+	if v.volSyncConfig.InitialSyncThroughput != nil && v.volSyncConfig.InitialSyncThroughput.Value() > 0 {
+		return float64(v.volSyncConfig.InitialSyncThroughput.Value())
+	}
+
+	return DefaultInitialSyncBytesPerSecond
+}
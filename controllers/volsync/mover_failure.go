@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+const (
+	// DefaultMoverFailureLogLines is the number of the last lines of the mover logs recorded for a failed sync,
+	// unless configured otherwise
+	DefaultMoverFailureLogLines = 10
+
+	// rsyncTLSSourceJobPrefix prefixes the name of the job VolSync runs the rsync-tls source mover of a
+	// ReplicationSource in, the job being named after the ReplicationSource
+	rsyncTLSSourceJobPrefix = "volsync-rsync-tls-src-"
+
+	// jobNameLabel is the label the job controller sets on the pods of a job to the name of the job
+	jobNameLabel = "job-name"
+)
+
+// MoverFailure returns the failure of the most recent sync of the ReplicationSource, or nil if it has not failed. A
+// sync is failed if VolSync reports its mover failed, or is failing, and thus likely stuck, if a container of the
+// mover pod terminated with an error or is waiting to be restarted. As VolSync deletes the mover pod of a failed
+// sync, the mover pod and reason of the previous failure, as last recorded, are kept once the pod is gone.
+func (v *VSHandler) MoverFailure(rs *volsyncv1alpha1.ReplicationSource,
+	previous *ramendrv1alpha1.VolSyncMoverFailure,
+) (*ramendrv1alpha1.VolSyncMoverFailure, error) {
+	pod, reason, err := v.failingMoverPod(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	var latestMoverStatus *volsyncv1alpha1.MoverStatus
+	if rs.Status != nil {
+		latestMoverStatus = rs.Status.LatestMoverStatus
+	}
+
+	moverFailed := latestMoverStatus != nil && latestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed
+
+	if pod == nil && !moverFailed {
+		return nil, nil
+	}
+
+	failure := &ramendrv1alpha1.VolSyncMoverFailure{}
+
+	switch {
+	case pod != nil:
+		failure.MoverPod = pod.GetName()
+		failure.Reason = reason
+	case previous != nil && previous.MoverPod != "":
+		failure.MoverPod = previous.MoverPod
+		failure.Reason = previous.Reason
+	default:
+		failure.Reason = string(volsyncv1alpha1.MoverResultFailed)
+	}
+
+	if moverFailed {
+		failure.Logs = lastLines(latestMoverStatus.Logs, v.moverFailureLogLines())
+	} else if previous != nil {
+		failure.Logs = previous.Logs
+	}
+
+	if previous == nil || *previous != *failure {
+		v.log.Info("ReplicationSource sync failed", "rs", rs.GetName(), "moverPod", failure.MoverPod,
+			"reason", failure.Reason)
+	}
+
+	return failure, nil
+}
+
+// failingMoverPod returns the most recently created pod of the mover job of the ReplicationSource, and the reason it
+// is failing, if it is failing
+func (v *VSHandler) failingMoverPod(rs *volsyncv1alpha1.ReplicationSource) (*corev1.Pod, string, error) {
+	podList := &corev1.PodList{}
+
+	err := v.client.List(v.ctx, podList,
+		client.InNamespace(rs.GetNamespace()),
+		client.MatchingLabels{jobNameLabel: rsyncTLSSourceJobPrefix + rs.GetName()})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list mover pods of ReplicationSource %s (%w)", rs.GetName(), err)
+	}
+
+	var newest *corev1.Pod
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if newest == nil || newest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			newest = pod
+		}
+	}
+
+	if newest == nil {
+		return nil, "", nil
+	}
+
+	reason := moverPodFailureReason(newest)
+	if reason == "" {
+		return nil, "", nil
+	}
+
+	return newest, reason, nil
+}
+
+// moverPodFailureReason returns the reason a container of the pod terminated with an error, or is waiting, other
+// than for its creation, or an empty string if no container is failing
+func moverPodFailureReason(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return fmt.Sprintf("%s (exit code %d)", terminated.Reason, terminated.ExitCode)
+		}
+
+		waiting := status.State.Waiting
+		if waiting == nil || waiting.Reason == "" || waiting.Reason == "ContainerCreating" ||
+			waiting.Reason == "PodInitializing" {
+			continue
+		}
+
+		if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			return fmt.Sprintf("%s (last exit code %d)", waiting.Reason, terminated.ExitCode)
+		}
+
+		return waiting.Reason
+	}
+
+	return ""
+}
+
+func (v *VSHandler) moverFailureLogLines() int {
+	if v.volSyncConfig.MoverFailureLogLines != 0 {
+		return v.volSyncConfig.MoverFailureLogLines
+	}
+
+	return DefaultMoverFailureLogLines
+}
+
+// lastLines returns the last n lines of the logs, or none if n is not positive
+func lastLines(logs string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(logs, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
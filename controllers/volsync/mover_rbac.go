@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"errors"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// PrivilegedMoversAnnotation on a namespace lets VolSync run privileged mover pods in it
+	PrivilegedMoversAnnotation = "volsync.backube/privileged-movers"
+
+	// PodSecurityEnforceLabel on a namespace is the pod security admission level enforced in it
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+
+	// PrivilegedMoverSCCName is the OpenShift SecurityContextConstraints VolSync grants to privileged movers
+	PrivilegedMoverSCCName = "volsync-privileged-mover"
+
+	securityContextConstraintsCRDName = "securitycontextconstraints.security.openshift.io"
+)
+
+// ErrVolSyncRBACMissing is returned when the namespace of a protected PVC lacks the permissions VolSync mover pods
+// need to start. The error message describes how to grant them.
+var ErrVolSyncRBACMissing = errors.New("volsync mover rbac missing")
+
+var securityContextConstraintsGVK = schema.GroupVersionKind{
+	Group:   "security.openshift.io",
+	Version: "v1",
+	Kind:    "SecurityContextConstraints",
+}
+
+// ValidateMoverRBAC checks that VolSync mover pods are able to start in the namespace, so that a missing permission
+// is reported rather than leaving the mover pods failing. Unprivileged movers need nothing beyond their
+// ServiceAccount, either the one configured for movers, which must exist, or the one VolSync creates for them. If the
+// namespace is annotated for privileged movers, its pod security admission level must permit privileged pods, and on
+// OpenShift the privileged mover SecurityContextConstraints must exist, and the ServiceAccount configured for movers
+// must be bound to its use, as VolSync binds only the ServiceAccounts it creates. The namespace, ServiceAccount and
+// SecurityContextConstraints are read once per namespace and reconcile, so they are read uncached.
+func (v *VSHandler) ValidateMoverRBAC(namespace string) error {
+	if v.moverRBACValidated[namespace] {
+		return nil
+	}
+
+	moverServiceAccount, err := v.getMoverServiceAccount(namespace)
+	if err != nil {
+		return err
+	}
+
+	ns := &corev1.Namespace{}
+	if err := v.apiReader.Get(v.ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return fmt.Errorf("error getting namespace %s (%w)", namespace, err)
+	}
+
+	if ns.GetAnnotations()[PrivilegedMoversAnnotation] != "true" {
+		v.moverRBACValidated[namespace] = true
+
+		return nil
+	}
+
+	if level := ns.GetLabels()[PodSecurityEnforceLabel]; level != "" && level != "privileged" {
+		return fmt.Errorf("%w: namespace %s is annotated %s=true but enforces pod security level %s, "+
+			"label the namespace %s=privileged or remove the annotation", ErrVolSyncRBACMissing, namespace,
+			PrivilegedMoversAnnotation, level, PodSecurityEnforceLabel)
+	}
+
+	if err := v.validatePrivilegedMoverSCC(namespace, moverServiceAccount); err != nil {
+		return err
+	}
+
+	v.moverRBACValidated[namespace] = true
+
+	return nil
+}
+
+// validatePrivilegedMoverSCC checks that the privileged mover SecurityContextConstraints exists, if the cluster
+// supports them, and that the ServiceAccount configured for movers, if any, may use it
+func (v *VSHandler) validatePrivilegedMoverSCC(namespace string, moverServiceAccount *string) error {
+	sccSupported, sccMissing, err := v.privilegedMoverSCCMissing()
+	if err != nil {
+		return err
+	}
+
+	if sccMissing {
+		return fmt.Errorf("%w: namespace %s is annotated %s=true but SecurityContextConstraints %s does not exist, "+
+			"reinstall VolSync or remove the annotation", ErrVolSyncRBACMissing, namespace,
+			PrivilegedMoversAnnotation, PrivilegedMoverSCCName)
+	}
+
+	if !sccSupported || moverServiceAccount == nil {
+		return nil
+	}
+
+	allowed, err := v.serviceAccountMaySCCUse(namespace, *moverServiceAccount)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return fmt.Errorf("%w: namespace %s is annotated %s=true but serviceaccount %s is not bound to use "+
+			"SecurityContextConstraints %s, grant it the use of the SecurityContextConstraints or remove the "+
+			"annotation", ErrVolSyncRBACMissing, namespace, PrivilegedMoversAnnotation, *moverServiceAccount,
+			PrivilegedMoverSCCName)
+	}
+
+	return nil
+}
+
+// serviceAccountMaySCCUse returns true if the ServiceAccount is authorized to use the privileged mover
+// SecurityContextConstraints, as reviewed by the API server
+func (v *VSHandler) serviceAccountMaySCCUse(namespace, serviceAccountName string) (bool, error) {
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   "system:serviceaccount:" + namespace + ":" + serviceAccountName,
+			Groups: []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace},
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "use",
+				Group:     securityContextConstraintsGVK.Group,
+				Resource:  "securitycontextconstraints",
+				Name:      PrivilegedMoverSCCName,
+			},
+		},
+	}
+
+	if err := v.client.Create(v.ctx, sar); err != nil {
+		return false, fmt.Errorf("error reviewing the use of SecurityContextConstraints %s by serviceaccount %s/%s "+
+			"(%w)", PrivilegedMoverSCCName, namespace, serviceAccountName, err)
+	}
+
+	return sar.Status.Allowed, nil
+}
+
+// privilegedMoverSCCMissing returns whether the cluster supports SecurityContextConstraints, i.e. is OpenShift, and
+// if so whether the one VolSync grants to privileged movers does not exist
+func (v *VSHandler) privilegedMoverSCCMissing() (supported, missing bool, err error) {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := v.apiReader.Get(v.ctx, types.NamespacedName{Name: securityContextConstraintsCRDName}, crd); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, false, nil
+		}
+
+		return false, false, fmt.Errorf("error getting CRD %s (%w)", securityContextConstraintsCRDName, err)
+	}
+
+	scc := &unstructured.Unstructured{}
+	scc.SetGroupVersionKind(securityContextConstraintsGVK)
+
+	if err := v.apiReader.Get(v.ctx, types.NamespacedName{Name: PrivilegedMoverSCCName}, scc); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, true, nil
+		}
+
+		return true, false, fmt.Errorf("error getting SecurityContextConstraints %s (%w)", PrivilegedMoverSCCName,
+			err)
+	}
+
+	return true, false, nil
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MigrateOwnerLabels relabels the ReplicationSources and ReplicationDestinations labeled as owned by the VRG with
+// the previous owner label keys, oldNameLabel and oldNamespaceLabel, with VRGOwnerNameLabel and
+// VRGOwnerNamespaceLabel, so that they are found again once the owner label keys change. oldNamespaceLabel may be
+// empty if the previous labels did not include the namespace of the VRG. Unless the VRG is in an admin namespace, an
+// object is only relabeled if it also has an owner reference to the VRG, as a cross-check. It returns the number of
+// objects relabeled.
+func (v *VSHandler) MigrateOwnerLabels(oldNameLabel, oldNamespaceLabel string) (int, error) {
+	matchLabels := map[string]string{oldNameLabel: v.owner.GetName()}
+	if oldNamespaceLabel != "" {
+		matchLabels[oldNamespaceLabel] = v.owner.GetNamespace()
+	}
+
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	if err := v.client.List(v.ctx, rsList, client.MatchingLabels(matchLabels)); err != nil {
+		return 0, fmt.Errorf("error listing ReplicationSources by label (%w)", err)
+	}
+
+	rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+	if err := v.client.List(v.ctx, rdList, client.MatchingLabels(matchLabels)); err != nil {
+		return 0, fmt.Errorf("error listing ReplicationDestinations by label (%w)", err)
+	}
+
+	objs := make([]client.Object, 0, len(rsList.Items)+len(rdList.Items))
+
+	for i := range rsList.Items {
+		objs = append(objs, &rsList.Items[i])
+	}
+
+	for i := range rdList.Items {
+		objs = append(objs, &rdList.Items[i])
+	}
+
+	migrated := 0
+
+	for _, obj := range objs {
+		if !v.vrgInAdminNamespace && !isOwnedBy(obj, v.owner) {
+			v.log.Info("Not migrating owner labels of object not owned by VRG", "kind",
+				obj.GetObjectKind().GroupVersionKind().Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+			continue
+		}
+
+		labels := obj.GetLabels()
+		delete(labels, oldNameLabel)
+
+		if oldNamespaceLabel != "" {
+			delete(labels, oldNamespaceLabel)
+		}
+
+		labels[VRGOwnerNameLabel] = v.owner.GetName()
+		labels[VRGOwnerNamespaceLabel] = v.owner.GetNamespace()
+		obj.SetLabels(labels)
+
+		if err := v.client.Update(v.ctx, obj); err != nil {
+			return migrated, fmt.Errorf("error migrating owner labels of %s/%s (%w)", obj.GetNamespace(),
+				obj.GetName(), err)
+		}
+
+		migrated++
+	}
+
+	v.log.Info("Migrated owner labels", "count", migrated)
+
+	return migrated, nil
+}
+
+// MigrateStaleOwnerNamespace finds the ReplicationSources and ReplicationDestinations labeled as owned by a VRG of
+// the same name in another namespace that no longer has that VRG, e.g. as the VRG was recreated in a different
+// namespace when re-onboarding. Those the VRG can own, i.e. all of them if the VRG is in an admin namespace, or those
+// in the namespace of the VRG otherwise, are migrated to the VRG, rather than creating a parallel set of them. It
+// returns the number of objects migrated, and the kind, namespace and name of those that could not be migrated, to
+// flag them.
+func (v *VSHandler) MigrateStaleOwnerNamespace() (int, []string, error) {
+	staleNamespace, err := labels.NewRequirement(VRGOwnerNamespaceLabel, selection.NotEquals,
+		[]string{v.owner.GetNamespace()})
+	if err != nil {
+		return 0, nil, fmt.Errorf("error building owner namespace selector (%w)", err)
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{VRGOwnerNameLabel: v.owner.GetName()}).Add(*staleNamespace)
+
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	if err := v.client.List(v.ctx, rsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, nil, fmt.Errorf("error listing ReplicationSources by label (%w)", err)
+	}
+
+	rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+	if err := v.client.List(v.ctx, rdList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, nil, fmt.Errorf("error listing ReplicationDestinations by label (%w)", err)
+	}
+
+	kinds := map[client.Object]string{}
+
+	for i := range rsList.Items {
+		kinds[&rsList.Items[i]] = "ReplicationSource"
+	}
+
+	for i := range rdList.Items {
+		kinds[&rdList.Items[i]] = "ReplicationDestination"
+	}
+
+	migrated := 0
+	stale := []string{}
+
+	for obj, kind := range kinds {
+		ownerExists, err := v.ownerExistsInNamespace(obj.GetLabels()[VRGOwnerNamespaceLabel])
+		if err != nil {
+			return migrated, stale, err
+		}
+
+		if ownerExists {
+			continue
+		}
+
+		if !v.vrgInAdminNamespace && obj.GetNamespace() != v.owner.GetNamespace() {
+			v.log.Info("Object owned by VRG in a stale namespace cannot be migrated", "kind", kind,
+				"name", obj.GetName(), "namespace", obj.GetNamespace())
+
+			stale = append(stale, fmt.Sprintf("%s %s/%s", kind, obj.GetNamespace(), obj.GetName()))
+
+			continue
+		}
+
+		if err := v.migrateOwnerNamespace(obj); err != nil {
+			return migrated, stale, fmt.Errorf("error migrating owner of %s %s/%s (%w)", kind,
+				obj.GetNamespace(), obj.GetName(), err)
+		}
+
+		migrated++
+	}
+
+	if migrated > 0 || len(stale) > 0 {
+		v.log.Info("Found objects owned by VRG in a stale namespace", "migrated", migrated, "stale", stale)
+	}
+
+	return migrated, stale, nil
+}
+
+// ownerExistsInNamespace returns true if an object of the kind and name of the owner exists in the namespace
+func (v *VSHandler) ownerExistsInNamespace(namespace string) (bool, error) {
+	owner, ok := v.owner.(client.Object)
+	if !ok {
+		return false, fmt.Errorf("owner %s is not a client object", v.owner.GetName())
+	}
+
+	other, _ := owner.DeepCopyObject().(client.Object)
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: v.owner.GetName(), Namespace: namespace}, other)
+	if err == nil {
+		return true, nil
+	}
+
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, fmt.Errorf("error getting owner %s/%s (%w)", namespace, v.owner.GetName(), err)
+}
+
+func (v *VSHandler) migrateOwnerNamespace(obj client.Object) error {
+	objLabels := obj.GetLabels()
+	objLabels[VRGOwnerNamespaceLabel] = v.owner.GetNamespace()
+	obj.SetLabels(objLabels)
+
+	if !v.vrgInAdminNamespace {
+		if err := ctrl.SetControllerReference(v.owner, obj, v.client.Scheme()); err != nil {
+			return fmt.Errorf("failed to set controller reference %w", err)
+		}
+	}
+
+	return v.client.Update(v.ctx, obj)
+}
+
+func isOwnedBy(obj, owner metav1.Object) bool {
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		if ownerRef.UID == owner.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TotalProtectedBytes sums the data protected by the ReplicationDestinations owned by the VRG, in all namespaces,
+// by their capacity, or by the restore size of their latest images if ProtectedBytesAccounting is Used. Local
+// ReplicationDestinations, kept only to restore from during a failover, are not counted.
+func (v *VSHandler) TotalProtectedBytes() (int64, error) {
+	rdList, err := v.listRDByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return 0, err
+	}
+
+	var totalBytes int64
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+
+		if rd.GetLabels()[VolSyncDoNotDeleteLabel] == VolSyncDoNotDeleteLabelVal {
+			continue
+		}
+
+		bytes, err := v.rdProtectedBytes(rd)
+		if err != nil {
+			return 0, err
+		}
+
+		totalBytes += bytes
+	}
+
+	return totalBytes, nil
+}
+
+func (v *VSHandler) rdProtectedBytes(rd *volsyncv1alpha1.ReplicationDestination) (int64, error) {
+	var capacityBytes int64
+
+	if rd.Spec.RsyncTLS != nil && rd.Spec.RsyncTLS.Capacity != nil {
+		capacityBytes = rd.Spec.RsyncTLS.Capacity.Value()
+	}
+
+	if v.volSyncConfig.ProtectedBytesAccounting != ProtectedBytesAccountingUsed ||
+		rd.Status == nil || !isLatestImageReady(rd.Status.LatestImage) {
+		return capacityBytes, nil
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{}
+
+	err := v.client.Get(v.ctx,
+		types.NamespacedName{Name: rd.Status.LatestImage.Name, Namespace: rd.GetNamespace()}, snapshot)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return capacityBytes, nil
+		}
+
+		return 0, fmt.Errorf("failed to get latest image %s of ReplicationDestination %s/%s (%w)",
+			rd.Status.LatestImage.Name, rd.GetNamespace(), rd.GetName(), err)
+	}
+
+	if snapshot.Status == nil || snapshot.Status.RestoreSize == nil {
+		return capacityBytes, nil
+	}
+
+	return snapshot.Status.RestoreSize.Value(), nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"context"
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PVCProtectionStatus is the VolSync protection status of a PVC on this cluster
+type PVCProtectionStatus struct {
+	// Protected is true if the PVC has a ReplicationSource or ReplicationDestination owned by a VRG
+	Protected bool
+	// VRG owning the ReplicationSource or ReplicationDestination of the PVC
+	VRG types.NamespacedName
+	// Primary is true if the PVC is replicated from this cluster by a ReplicationSource, false if it is
+	// replicated to this cluster by a ReplicationDestination
+	Primary bool
+	// DataProtected is true once at least one sync of the PVC has completed
+	DataProtected bool
+	// LatestImage is the latest image of the ReplicationDestination of the PVC, if any
+	LatestImage *corev1.TypedLocalObjectReference
+}
+
+// GetPVCProtectionStatus returns the VolSync protection status of a PVC, without knowing which VRG protects it. The
+// VRG is located using the owner labels of the ReplicationSource or ReplicationDestination of the PVC.
+func GetPVCProtectionStatus(ctx context.Context, c client.Client, log logr.Logger, pvc types.NamespacedName,
+) (PVCProtectionStatus, error) {
+	status := PVCProtectionStatus{}
+
+	rs := &volsyncv1alpha1.ReplicationSource{}
+
+	vrg, err := getVRGOwner(ctx, c, types.NamespacedName{Name: getReplicationSourceName(pvc.Name),
+		Namespace: pvc.Namespace}, rs)
+	if err != nil {
+		return status, err
+	}
+
+	if vrg != nil {
+		status.Protected = true
+		status.VRG = *vrg
+		status.Primary = true
+
+		v := newVSHandlerForVRG(ctx, c, log, *vrg)
+
+		status.DataProtected, err = v.IsRSDataProtected(pvc.Name, pvc.Namespace)
+
+		return status, err
+	}
+
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+
+	vrg, err = getVRGOwner(ctx, c, types.NamespacedName{Name: getReplicationDestinationName(pvc.Name),
+		Namespace: pvc.Namespace}, rd)
+	if err != nil || vrg == nil {
+		return status, err
+	}
+
+	status.Protected = true
+	status.VRG = *vrg
+
+	v := newVSHandlerForVRG(ctx, c, log, *vrg)
+
+	status.LatestImage, err = v.getRDLatestImage(pvc.Name, pvc.Namespace)
+	if err != nil {
+		return status, err
+	}
+
+	status.DataProtected, err = v.IsRDDataProtected(pvc.Name, pvc.Namespace)
+
+	return status, err
+}
+
+// getVRGOwner gets the object and returns the VRG named by its owner labels, or nil if the object does not exist or
+// is not owned by a VRG
+func getVRGOwner(ctx context.Context, c client.Client, key types.NamespacedName, obj client.Object,
+) (*types.NamespacedName, error) {
+	if err := c.Get(ctx, key, obj); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting %s (%w)", key, err)
+	}
+
+	labels := obj.GetLabels()
+	if labels[VRGOwnerNameLabel] == "" || labels[VRGOwnerNamespaceLabel] == "" {
+		return nil, nil
+	}
+
+	return &types.NamespacedName{Name: labels[VRGOwnerNameLabel], Namespace: labels[VRGOwnerNamespaceLabel]}, nil
+}
+
+func newVSHandlerForVRG(ctx context.Context, c client.Client, log logr.Logger, vrg types.NamespacedName,
+) *VSHandler {
+	owner := &metav1.ObjectMeta{Name: vrg.Name, Namespace: vrg.Namespace}
+
+	return NewVSHandler(ctx, c, log.WithValues("vrg", vrg.String()), owner, nil, "",
+		string(volsyncv1alpha1.CopyMethodSnapshot), false, nil)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// TakeoverPendingAnnotation is set on a ReplicationDestination whose cleanup is deferred until the takeover of its
+// owner as primary is confirmed
+const TakeoverPendingAnnotation = "ramendr.openshift.io/takeover-pending"
+
+// DeferRDCleanup pauses the owned ReplicationDestination of the PVC, instead of deleting it, and flags it as pending
+// the takeover of its owner as primary, for it to be resumed by ReconcileRD if the takeover is rolled back. The RD is
+// kept alongside the ReplicationSource of the PVC until the RDSpec list of the owner is cleared.
+func (v *VSHandler) DeferRDCleanup(pvcName, pvcNamespace string) error {
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+
+	found, err := v.getOwned(getReplicationDestinationName(pvcName), pvcNamespace, rd)
+	if err != nil || !found {
+		return err
+	}
+
+	v.deferredRDs[pvcNamespace+"/"+pvcName] = true
+
+	pause := v.Capabilities().Pause
+	if takeoverPending(rd) && (rd.Spec.Paused || !pause) {
+		return nil
+	}
+
+	util.AddAnnotation(rd, TakeoverPendingAnnotation, "true")
+
+	if pause {
+		rd.Spec.Paused = true
+	}
+
+	v.log.Info("Deferring ReplicationDestination cleanup until takeover is confirmed", "name", rd.GetName())
+
+	return v.updateResource(rd)
+}
+
+// resumeDeferredRD resumes the ReplicationDestination if it was paused by DeferRDCleanup, as its owner is secondary
+// again
+func (v *VSHandler) resumeDeferredRD(rdName, rdNamespace string) error {
+	rd, err := v.getRD(rdName, rdNamespace)
+	if err != nil || rd == nil || !takeoverPending(rd) {
+		return err
+	}
+
+	delete(rd.Annotations, TakeoverPendingAnnotation)
+
+	rd.Spec.Paused = false
+
+	v.log.Info("Resuming ReplicationDestination as takeover was rolled back", "name", rd.GetName())
+
+	return v.updateResource(rd)
+}
+
+func takeoverPending(rd *volsyncv1alpha1.ReplicationDestination) bool {
+	_, ok := rd.GetAnnotations()[TakeoverPendingAnnotation]
+
+	return ok
+}
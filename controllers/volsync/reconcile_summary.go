@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ReconcileSummary counts the ReplicationSources and ReplicationDestinations a VSHandler created, updated or left
+// unchanged, as it reconciled them
+type ReconcileSummary struct {
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+// Changed returns true if any ReplicationSource or ReplicationDestination was created or updated
+func (s ReconcileSummary) Changed() bool {
+	return s.Created+s.Updated > 0
+}
+
+func (s *ReconcileSummary) record(op ctrlutil.OperationResult) {
+	switch op {
+	case ctrlutil.OperationResultCreated:
+		s.Created++
+	case ctrlutil.OperationResultUpdated, ctrlutil.OperationResultUpdatedStatus,
+		ctrlutil.OperationResultUpdatedStatusOnly:
+		s.Updated++
+	case ctrlutil.OperationResultNone:
+		s.Unchanged++
+	}
+}
+
+// ReconcileSummary returns the summary of the ReplicationSources and ReplicationDestinations reconciled by the
+// VSHandler so far. A summary that has not Changed means the reconciles were no-ops, so that the caller may skip
+// the work that follows a change, e.g. updating its status.
+func (v *VSHandler) ReconcileSummary() ReconcileSummary {
+	return v.reconcileSummary
+}
+
+// applyOperationResult returns the operation result of a server-side apply of obj, given the object as it existed
+// before the apply, or nil if it did not exist. Changes to the object status are not considered.
+func applyOperationResult(existing, obj client.Object) ctrlutil.OperationResult {
+	switch {
+	case existing == nil:
+		return ctrlutil.OperationResultCreated
+	case existing.GetGeneration() != obj.GetGeneration(),
+		!reflect.DeepEqual(existing.GetLabels(), obj.GetLabels()),
+		!reflect.DeepEqual(existing.GetAnnotations(), obj.GetAnnotations()),
+		!reflect.DeepEqual(existing.GetOwnerReferences(), obj.GetOwnerReferences()):
+		return ctrlutil.OperationResultUpdated
+	default:
+		return ctrlutil.OperationResultNone
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// Stores of the rsync-tls pre-shared key secrets used by VolSync
+	PSKSecretStoreNative   = "Native"
+	PSKSecretStoreExternal = "External"
+
+	// Key of the pre-shared key in the rsync-tls secret, as expected by VolSync
+	PSKSecretDataKey = "psk.txt"
+)
+
+// ErrPSKSecretInvalid is returned when the rsync-tls pre-shared key secret synced by an external secret store does
+// not contain the pre-shared key
+var ErrPSKSecretInvalid = errors.New("psk secret invalid")
+
+// pskSecretStore makes the rsync-tls pre-shared key secret of the owner available to the VolSync movers in the
+// namespace of a protected PVC
+type pskSecretStore interface {
+	// ensurePSKSecret returns true if the secret is present in the namespace, or false to wait for it
+	ensurePSKSecret(secretName, pvcNamespace string) (bool, error)
+}
+
+// pskSecretOwner returns the configured owner of the Native pre-shared key secrets, or the owner of the VSHandler by
+// default
+func (v *VSHandler) pskSecretOwner() (metav1.Object, error) {
+	ownerConfig := v.volSyncConfig.PSKSecretOwner
+	if ownerConfig == nil {
+		return v.owner, nil
+	}
+
+	owner := &unstructured.Unstructured{}
+	owner.SetAPIVersion(ownerConfig.APIVersion)
+	owner.SetKind(ownerConfig.Kind)
+
+	// The namespace is disregarded for a cluster scoped owner
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: ownerConfig.Name, Namespace: v.owner.GetNamespace()}, owner)
+	if err != nil {
+		return nil, fmt.Errorf("error getting psk secret owner %s %s (%w)", ownerConfig.Kind, ownerConfig.Name, err)
+	}
+
+	return owner, nil
+}
+
+func (v *VSHandler) pskSecretStore() pskSecretStore {
+	if v.volSyncConfig.PSKSecretStore == PSKSecretStoreExternal {
+		return externalPSKSecretStore{v: v}
+	}
+
+	return nativePSKSecretStore{v: v}
+}
+
+// nativePSKSecretStore uses the secret propagated by the hub to the namespace of the owner, which the owner takes
+// ownership of. An owner in an admin namespace copies the secret to the PVC namespace.
+type nativePSKSecretStore struct {
+	v *VSHandler
+}
+
+func (s nativePSKSecretStore) ensurePSKSecret(secretName, pvcNamespace string) (bool, error) {
+	// Need to confirm this secret exists on the cluster before proceeding, otherwise volsync will generate it
+	secretExists, err := s.v.validateSecretAndAddVRGOwnerRef(secretName)
+	if err != nil || !secretExists {
+		return false, err
+	}
+
+	if s.v.vrgInAdminNamespace {
+		// copy the secret to the namespace where the PVC is
+		if err := s.v.copySecretToPVCNamespace(secretName, pvcNamespace); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// externalPSKSecretStore uses the secret synced to the PVC namespace by an external secret store, e.g. from
+// HashiCorp Vault by the Secrets Store CSI driver or the External Secrets Operator. The secret is only validated,
+// as its lifecycle belongs to the external secret store.
+type externalPSKSecretStore struct {
+	v *VSHandler
+}
+
+func (s externalPSKSecretStore) ensurePSKSecret(secretName, pvcNamespace string) (bool, error) {
+	secret := &corev1.Secret{}
+
+	err := s.v.client.Get(s.v.ctx, types.NamespacedName{Name: secretName, Namespace: pvcNamespace}, secret)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, fmt.Errorf("error getting secret %s/%s (%w)", pvcNamespace, secretName, err)
+		}
+
+		s.v.log.Info("Secret not synced by the external secret store yet", "secretName", secretName,
+			"pvcNamespace", pvcNamespace)
+
+		return false, nil
+	}
+
+	if len(secret.Data[PSKSecretDataKey]) == 0 {
+		return false, fmt.Errorf("%w, secret %s/%s synced by the external secret store has no %s key",
+			ErrPSKSecretInvalid, pvcNamespace, secretName, PSKSecretDataKey)
+	}
+
+	s.v.log.V(1).Info("VolSync secret validated", "secret name", secretName, "pvcNamespace", pvcNamespace)
+
+	return true, nil
+}
@@ -81,7 +81,7 @@ func generateNewVolSyncReplicationSecret(secretName, secretNamespace string, log
 			},
 		},
 		StringData: map[string]string{
-			"psk.txt": "volsyncramen:" + tlsKey,
+			PSKSecretDataKey: "volsyncramen:" + tlsKey,
 		},
 	}
 
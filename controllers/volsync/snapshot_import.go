@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers/util"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ImportSnapshotPVCLabel is set on the VolumeSnapshots taken of a PVC to record their handle for import by the peer
+// cluster, with the name of the PVC as value
+const ImportSnapshotPVCLabel = "ramendr.openshift.io/import-snapshot-pvc"
+
+const (
+	importSnapshotNameSuffix         = "-ramen-import"
+	importSnapshotContentNamePrefix  = "ramen-import-"
+	importSnapshotDefaultIntervalMin = 10 // matches DefaultScheduleCronSpec
+)
+
+// EnsureImportSnapshot takes a VolumeSnapshot of the source PVC once per scheduling interval, if snapshot import is
+// enabled, and returns the backend handle of the most recent one that is ready, for the peer cluster to import. Older
+// snapshots are deleted once a newer one is ready. Returns nil if snapshot import is disabled, or no snapshot is ready
+// yet.
+func (v *VSHandler) EnsureImportSnapshot(rsSpec ramendrv1alpha1.VolSyncReplicationSourceSpec,
+) (*ramendrv1alpha1.VolSyncSnapshotImport, error) {
+	if !v.volSyncConfig.SnapshotImportEnabled {
+		return nil, nil
+	}
+
+	pvcName := rsSpec.ProtectedPVC.Name
+	pvcNamespace := rsSpec.ProtectedPVC.Namespace
+
+	snapshots, err := v.listImportSnapshots(pvcName, pvcNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := v.schedulingIntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshots) == 0 || time.Since(snapshots[0].GetCreationTimestamp().Time) >= interval {
+		if err := v.createImportSnapshot(rsSpec.ProtectedPVC); err != nil {
+			return nil, err
+		}
+	}
+
+	latestReady := -1
+
+	for i := range snapshots {
+		if isSnapshotReady(&snapshots[i]) {
+			latestReady = i
+
+			break
+		}
+	}
+
+	if latestReady == -1 {
+		return nil, nil
+	}
+
+	for i := latestReady + 1; i < len(snapshots); i++ {
+		if err := v.client.Delete(v.ctx, &snapshots[i]); err != nil && !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error deleting import snapshot %s/%s (%w)", pvcNamespace,
+				snapshots[i].GetName(), err)
+		}
+	}
+
+	return v.snapshotImportFromSnapshot(&snapshots[latestReady])
+}
+
+// listImportSnapshots returns the import snapshots of the PVC owned by the VRG, newest first
+func (v *VSHandler) listImportSnapshots(pvcName, pvcNamespace string) ([]snapv1.VolumeSnapshot, error) {
+	snapList := &snapv1.VolumeSnapshotList{}
+
+	err := v.client.List(v.ctx, snapList, client.InNamespace(pvcNamespace), client.MatchingLabels{
+		ImportSnapshotPVCLabel: pvcName,
+		VRGOwnerNameLabel:      v.owner.GetName(),
+		VRGOwnerNamespaceLabel: v.owner.GetNamespace(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing import snapshots of pvc %s/%s (%w)", pvcNamespace, pvcName, err)
+	}
+
+	snapshots := snapList.Items
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[j].GetCreationTimestamp().Time.Before(snapshots[i].GetCreationTimestamp().Time)
+	})
+
+	return snapshots, nil
+}
+
+func (v *VSHandler) createImportSnapshot(protectedPVC ramendrv1alpha1.ProtectedPVC) error {
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s%s-%d", protectedPVC.Name, importSnapshotNameSuffix, time.Now().Unix()),
+			Namespace: protectedPVC.Namespace,
+			Labels: map[string]string{
+				ImportSnapshotPVCLabel: protectedPVC.Name,
+				VRGOwnerNameLabel:      v.owner.GetName(),
+				VRGOwnerNamespaceLabel: v.owner.GetNamespace(),
+			},
+		},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source: snapv1.VolumeSnapshotSource{
+				PersistentVolumeClaimName: &protectedPVC.Name,
+			},
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+		},
+	}
+
+	if !v.vrgInAdminNamespace {
+		if err := ctrl.SetControllerReference(v.owner, snapshot, v.client.Scheme()); err != nil {
+			return fmt.Errorf("failed to set controller reference %w", err)
+		}
+	}
+
+	if err := v.client.Create(v.ctx, snapshot); err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating import snapshot %s/%s (%w)", snapshot.GetNamespace(),
+			snapshot.GetName(), err)
+	}
+
+	v.log.Info("Created import snapshot", "name", snapshot.GetName(), "namespace", snapshot.GetNamespace())
+
+	return nil
+}
+
+func (v *VSHandler) snapshotImportFromSnapshot(snapshot *snapv1.VolumeSnapshot,
+) (*ramendrv1alpha1.VolSyncSnapshotImport, error) {
+	content := &snapv1.VolumeSnapshotContent{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: *snapshot.Status.BoundVolumeSnapshotContentName}, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VolumeSnapshotContent of VolumeSnapshot %s (%w)", snapshot.GetName(), err)
+	}
+
+	if content.Status == nil || content.Status.SnapshotHandle == nil {
+		return nil, nil
+	}
+
+	snapshotImport := &ramendrv1alpha1.VolSyncSnapshotImport{
+		SnapshotHandle: *content.Status.SnapshotHandle,
+		Driver:         content.Spec.Driver,
+	}
+
+	if snapshot.Spec.VolumeSnapshotClassName != nil {
+		snapshotImport.VolumeSnapshotClassName = *snapshot.Spec.VolumeSnapshotClassName
+	}
+
+	return snapshotImport, nil
+}
+
+// importSnapshot pre-provisions a VolumeSnapshotContent for the snapshot handle recorded by the peer cluster, and a
+// VolumeSnapshot bound to it, and returns a reference to the VolumeSnapshot to restore the PVC from. The content is
+// retained when the VolumeSnapshot is deleted, as the snapshot on the storage backend belongs to the peer cluster.
+func (v *VSHandler) importSnapshot(protectedPVC ramendrv1alpha1.ProtectedPVC,
+) (*corev1.TypedLocalObjectReference, error) {
+	snapshotImport := protectedPVC.SnapshotImport
+
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(protectedPVC.Namespace + "/" + protectedPVC.Name + "/" + snapshotImport.SnapshotHandle))
+
+	suffix := fmt.Sprintf("%08x", hash.Sum32())
+	snapshotName := protectedPVC.Name + importSnapshotNameSuffix + "-" + suffix
+	contentName := importSnapshotContentNamePrefix + suffix
+
+	labels := map[string]string{
+		VRGOwnerNameLabel:      v.owner.GetName(),
+		VRGOwnerNamespaceLabel: v.owner.GetNamespace(),
+	}
+
+	content := &snapv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: contentName, Labels: labels},
+		Spec: snapv1.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapv1.VolumeSnapshotContentRetain,
+			Driver:         snapshotImport.Driver,
+			Source: snapv1.VolumeSnapshotContentSource{
+				SnapshotHandle: &snapshotImport.SnapshotHandle,
+			},
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Name:      snapshotName,
+				Namespace: protectedPVC.Namespace,
+			},
+		},
+	}
+
+	if err := v.client.Create(v.ctx, content); err != nil && !kerrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("error creating VolumeSnapshotContent %s to import snapshot (%w)", contentName, err)
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: snapshotName, Namespace: protectedPVC.Namespace, Labels: labels},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source: snapv1.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &contentName,
+			},
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+		},
+	}
+
+	if err := v.client.Create(v.ctx, snapshot); err != nil && !kerrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("error creating VolumeSnapshot %s/%s to import snapshot (%w)",
+			protectedPVC.Namespace, snapshotName, err)
+	}
+
+	v.log.Info("Imported snapshot", "name", snapshotName, "content", contentName)
+
+	apiGroup := snapv1.GroupName
+
+	return &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     "VolumeSnapshot",
+		Name:     snapshotName,
+	}, nil
+}
+
+func (v *VSHandler) schedulingIntervalDuration() (time.Duration, error) {
+	if v.schedulingInterval == "" {
+		return importSnapshotDefaultIntervalMin * time.Minute, nil
+	}
+
+	seconds, err := util.SchedulingIntervalSeconds(v.schedulingInterval)
+	if err != nil {
+		return 0, fmt.Errorf("scheduling interval %s is invalid (%w)", v.schedulingInterval, err)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func isSnapshotReady(snapshot *snapv1.VolumeSnapshot) bool {
+	return snapshot.Status != nil && snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse &&
+		snapshot.Status.BoundVolumeSnapshotContentName != nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// currentStorageClassName returns the name of the current equivalent of the storage class recorded as
+// storageClassName, following the configured storage class aliases, e.g. of classes renamed on a cluster upgrade.
+// A name without an alias is current. Aliases are followed until a name without an alias, or a cycle, is reached.
+func (v *VSHandler) currentStorageClassName(storageClassName *string) *string {
+	if storageClassName == nil || len(v.volSyncConfig.StorageClassAliases) == 0 {
+		return storageClassName
+	}
+
+	current := *storageClassName
+	visited := map[string]bool{current: true}
+
+	for {
+		alias, ok := v.volSyncConfig.StorageClassAliases[current]
+		if !ok || alias == "" || visited[alias] {
+			break
+		}
+
+		current = alias
+		visited[current] = true
+	}
+
+	if current == *storageClassName {
+		return storageClassName
+	}
+
+	v.log.V(1).Info("Storage class aliased", "recorded", *storageClassName, "current", current)
+
+	return &current
+}
+
+// resolveStorageClassAlias replaces the storage class name recorded for the protected PVC with the name of its
+// current equivalent, for the ReplicationSource, ReplicationDestination and restored PVC to use
+func (v *VSHandler) resolveStorageClassAlias(protectedPVC *ramendrv1alpha1.ProtectedPVC) {
+	protectedPVC.StorageClassName = v.currentStorageClassName(protectedPVC.StorageClassName)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// rsyncSummaryRegex matches the transfer summary rsync logs at the end of a sync, e.g.
+// "sent 1,234 bytes  received 56 bytes  2,580.00 bytes/sec"
+var rsyncSummaryRegex = regexp.MustCompile(
+	`sent ([0-9][0-9,]*) bytes\s+received ([0-9][0-9,]*) bytes\s+([0-9][0-9,]*(?:\.[0-9]+)?) bytes/sec`)
+
+// SyncStats are the transfer statistics of the most recent completed sync of a PVC
+type SyncStats struct {
+	// Available is false if there is no completed sync, or the installed VolSync does not report the transfer
+	// statistics of the mover, in which case only the fields read from the ReplicationSource status are set
+	Available bool
+	// LastSyncTime is the time the most recent sync completed
+	LastSyncTime *metav1.Time
+	// Duration of the most recent sync
+	Duration time.Duration
+	// BytesTransferred is the number of bytes sent and received by the mover
+	BytesTransferred int64
+	// BytesPerSecond is the throughput of the mover, as it reports it
+	BytesPerSecond float64
+}
+
+// LastSyncStats returns the transfer statistics of the most recent completed sync of the PVC, as logged by the mover
+// of its ReplicationSource owned by the VRG. SyncStats are not available if there is no such ReplicationSource, if it
+// has not completed a sync, or if the installed VolSync does not report the mover logs in the ReplicationSource status.
+func (v *VSHandler) LastSyncStats(pvcName, pvcNamespace string) (SyncStats, error) {
+	rs, err := v.getRS(getReplicationSourceName(pvcName), pvcNamespace)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return SyncStats{}, nil
+		}
+
+		return SyncStats{}, err
+	}
+
+	if !util.HasLabelWithValue(rs, VRGOwnerNameLabel, v.owner.GetName()) ||
+		!util.HasLabelWithValue(rs, VRGOwnerNamespaceLabel, v.owner.GetNamespace()) {
+		v.log.Info("ReplicationSource is not owned by this VRG, no sync stats", "name", rs.GetName())
+
+		return SyncStats{}, nil
+	}
+
+	return syncStatsFromStatus(rs.Status), nil
+}
+
+func syncStatsFromStatus(status *volsyncv1alpha1.ReplicationSourceStatus) SyncStats {
+	stats := SyncStats{}
+
+	if status == nil || status.LastSyncTime == nil {
+		return stats
+	}
+
+	stats.LastSyncTime = status.LastSyncTime
+
+	if status.LastSyncDuration != nil {
+		stats.Duration = status.LastSyncDuration.Duration
+	}
+
+	if status.LatestMoverStatus == nil ||
+		status.LatestMoverStatus.Result != volsyncv1alpha1.MoverResultSuccessful {
+		return stats
+	}
+
+	match := rsyncSummaryRegex.FindStringSubmatch(status.LatestMoverStatus.Logs)
+	if match == nil {
+		return stats
+	}
+
+	sent, err := strconv.ParseInt(strings.ReplaceAll(match[1], ",", ""), 10, 64)
+	if err != nil {
+		return stats
+	}
+
+	received, err := strconv.ParseInt(strings.ReplaceAll(match[2], ",", ""), 10, 64)
+	if err != nil {
+		return stats
+	}
+
+	bytesPerSecond, err := strconv.ParseFloat(strings.ReplaceAll(match[3], ",", ""), 64)
+	if err != nil {
+		return stats
+	}
+
+	stats.Available = true
+	stats.BytesTransferred = sent + received
+	stats.BytesPerSecond = bytesPerSecond
+
+	return stats
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"sort"
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// SyncThrottledAnnotation is set on a ReplicationSource that is paused by ramen to cap the concurrent syncs of its
+// owner. Ramen resumes only the ReplicationSources it paused, and leaves a pause by anyone else alone.
+const SyncThrottledAnnotation = "ramendr.openshift.io/sync-throttled"
+
+// AssignSyncSlots assigns the sync slots of the owner, if the maximum number of its concurrent syncs is configured
+// and VolSync supports pausing a ReplicationSource, to be called once per reconcile of the owner before its
+// ReplicationSources are reconciled. The ReplicationSources of the PVCs that are not created yet are assigned slots
+// as well. Of the ReplicationSources of the owner, those syncing keep syncing, and the remaining sync slots go to
+// those that synced least recently, those that never synced first. A ReplicationSource that completes its sync thus
+// gives up its slot to the next one, which staggers the syncs of the owner so that at most the configured number of
+// them run at once. The local direct copy ReplicationSources, of a failover to the same cluster, are not throttled.
+func (v *VSHandler) AssignSyncSlots(pvcs []types.NamespacedName) error {
+	v.syncSlots = nil
+
+	maxConcurrentSyncs := v.volSyncConfig.MaxConcurrentSyncs
+	if maxConcurrentSyncs <= 0 {
+		return nil
+	}
+
+	if !v.Capabilities().Pause {
+		v.log.Info("Concurrent syncs not capped, as VolSync does not support pausing a ReplicationSource")
+
+		return nil
+	}
+
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]*volsyncv1alpha1.ReplicationSource, 0, len(rsList.Items)+len(pvcs))
+	listed := map[types.NamespacedName]bool{}
+
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		if isLocalRS(rs) {
+			continue
+		}
+
+		listed[client.ObjectKeyFromObject(rs)] = true
+		candidates = append(candidates, rs)
+	}
+
+	for _, pvc := range pvcs {
+		key := types.NamespacedName{Name: getReplicationSourceName(pvc.Name), Namespace: pvc.Namespace}
+		if listed[key] {
+			continue
+		}
+
+		// Not created yet
+		listed[key] = true
+		candidates = append(candidates, &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return syncSlotLess(candidates[i], candidates[j])
+	})
+
+	v.syncSlots = map[types.NamespacedName]bool{}
+	for i := 0; i < len(candidates) && i < maxConcurrentSyncs; i++ {
+		v.syncSlots[client.ObjectKeyFromObject(candidates[i])] = true
+	}
+
+	return nil
+}
+
+// syncThrottled returns true if the ReplicationSource is to be paused, as the sync slots of the owner are assigned
+// and it holds none of them
+func (v *VSHandler) syncThrottled(rsName, rsNamespace string) bool {
+	if v.syncSlots == nil {
+		return false
+	}
+
+	key := types.NamespacedName{Name: rsName, Namespace: rsNamespace}
+	if v.syncSlots[key] {
+		return false
+	}
+
+	v.log.Info("ReplicationSource sync throttled", "rs", key.String(),
+		"maxConcurrentSyncs", v.volSyncConfig.MaxConcurrentSyncs)
+
+	return true
+}
+
+// mutateRSSyncThrottle pauses the ReplicationSource if it is throttled, and resumes it once it is no longer throttled
+// only if it is ramen that paused it
+func mutateRSSyncThrottle(rs *volsyncv1alpha1.ReplicationSource, throttled bool) {
+	_, paused := rs.GetAnnotations()[SyncThrottledAnnotation]
+
+	switch {
+	case throttled && !rs.Spec.Paused:
+		util.AddAnnotation(rs, SyncThrottledAnnotation, "true")
+
+		rs.Spec.Paused = true
+	case !throttled && paused:
+		delete(rs.Annotations, SyncThrottledAnnotation)
+
+		rs.Spec.Paused = false
+	}
+}
+
+// isLocalRS returns true for a local direct copy ReplicationSource, of a failover to the same cluster
+func isLocalRS(rs *volsyncv1alpha1.ReplicationSource) bool {
+	return rs.Spec.RsyncTLS != nil &&
+		rs.Spec.RsyncTLS.CopyMethod == volsyncv1alpha1.CopyMethodDirect
+}
+
+// syncSlotLess orders ReplicationSources syncing first, then by their last sync time, those that never synced first,
+// then by namespaced name
+func syncSlotLess(a, b *volsyncv1alpha1.ReplicationSource) bool {
+	aSyncing, bSyncing := isRSSyncing(a), isRSSyncing(b)
+	if aSyncing != bSyncing {
+		return aSyncing
+	}
+
+	aLastSync, bLastSync := rsLastSyncTime(a), rsLastSyncTime(b)
+	if !aLastSync.Equal(bLastSync) {
+		return aLastSync.Before(bLastSync)
+	}
+
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+
+	return a.GetName() < b.GetName()
+}
+
+// isRSSyncing returns true if a sync of the ReplicationSource is in progress. VolSync clears the last sync start time
+// once the sync completes.
+func isRSSyncing(rs *volsyncv1alpha1.ReplicationSource) bool {
+	return rs.Status != nil && rs.Status.LastSyncStartTime != nil
+}
+
+func rsLastSyncTime(rs *volsyncv1alpha1.ReplicationSource) time.Time {
+	if rs.Status == nil || rs.Status.LastSyncTime == nil {
+		return time.Time{}
+	}
+
+	return rs.Status.LastSyncTime.Time
+}
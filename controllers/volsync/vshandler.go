@@ -5,14 +5,21 @@ package volsync
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,9 +27,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/tools/reference"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
@@ -42,14 +52,28 @@ const (
 	PodVolumePVCClaimIndexName    string = "spec.volumes.persistentVolumeClaim.claimName"
 	VolumeAttachmentToPVIndexName string = "spec.source.persistentVolumeName"
 
+	// Prefix of the annotations reserved for Ramen
+	ramenAnnotationPrefix string = "ramendr.openshift.io/"
+
 	VRGOwnerNameLabel      string = "volumereplicationgroups-owner"
 	VRGOwnerNamespaceLabel string = "volumereplicationgroups-owner-namespace"
 
 	FinalSyncTriggerString string = "vrg-final-sync"
 
+	// InitialSyncTriggerString is the manual trigger of the first sync of a ReplicationSource, when configured to
+	// run immediately on its creation
+	InitialSyncTriggerString string = "vrg-initial-sync"
+
+	// DefaultFinalSyncTimeout is the time a final sync is waited for, once its mover has started syncing, unless
+	// configured otherwise
+	DefaultFinalSyncTimeout = 6 * time.Hour
+
 	SchedulingIntervalMinLength int = 2
 	CronSpecMaxDayOfMonth       int = 28
 
+	minutesPerHour = 60
+	hoursPerDay    = 24
+
 	VolSyncDoNotDeleteLabel    = "volsync.backube/do-not-delete" // TODO: point to volsync constant once it is available
 	VolSyncDoNotDeleteLabelVal = "true"
 
@@ -60,44 +84,309 @@ const (
 
 	OwnerNameAnnotation      = "ramendr.openshift.io/owner-name"
 	OwnerNamespaceAnnotation = "ramendr.openshift.io/owner-namespace"
+
+	// Default field manager of the objects written by the VSHandler, including when applying ReplicationSources and
+	// ReplicationDestinations with server-side apply
+	FieldManagerName = "ramen-volsync"
+
+	// StorageClass annotation listing the comma separated access modes that the storage class supports
+	SupportedAccessModesAnnotation = "ramendr.openshift.io/supported-access-modes"
+
+	// Actions taken when the source PVC of an existing ReplicationSource is missing
+	SourcePVCMissingActionWait   = "Wait"
+	SourcePVCMissingActionDelete = "Delete"
+
+	// Modes of the PVCs restored from snapshots, usable immediately, or quarantined until released
+	RestoredPVCModeImmediate  = "Immediate"
+	RestoredPVCModeQuarantine = "Quarantine"
+
+	// Accounting of the data protected by the ReplicationDestinations, by their capacity or their latest image size
+	ProtectedBytesAccountingRequested = "Requested"
+	ProtectedBytesAccountingUsed      = "Used"
+
+	// Label of a restored PVC that is quarantined, and the annotation releasing it once its data is scanned
+	QuarantineLabel                 = "ramendr.openshift.io/quarantined"
+	QuarantineLabelVal              = "true"
+	QuarantineReleasedAnnotation    = "ramendr.openshift.io/quarantine-released"
+	QuarantineReleasedAnnotationVal = "true"
+
+	// StorageClass annotation naming the VolumeSnapshotClass to use for its PVCs, overriding the selection by driver
+	VolumeSnapshotClassAnnotation = "ramendr.openshift.io/volumesnapshotclass"
+
+	// VolumeSnapshotClass annotation declaring that PVCs restored from its snapshots may be mounted ReadOnlyMany, for
+	// drivers other than CephFS, which supports it
+	ReadOnlyManyRestoreAnnotation    = "ramendr.openshift.io/readonlymany-restore"
+	ReadOnlyManyRestoreAnnotationVal = "true"
+
+	// PVC annotation requesting a capacity for the PVC when it is restored, e.g. on failover, that is larger than
+	// the capacity requested by the PVC when it was protected
+	RestoreCapacityAnnotation = "ramendr.openshift.io/restore-capacity"
+
+	// VolumeSnapshot annotation recording the RFC 3339 time until which the snapshot is retained, and so its
+	// do-not-delete label is not released
+	RetainUntilAnnotation = "ramendr.openshift.io/retain-until"
 )
 
+// ErrAccessModeNotSupported is returned when a protected PVC requests an access mode its storage class does not support
+var ErrAccessModeNotSupported = errors.New("access mode not supported by storage class")
+
+// ErrSnapshotNotReady is returned when a volume snapshot to restore a PVC from is not yet ready to use
+var ErrSnapshotNotReady = errors.New("snapshot not ready to use")
+
+// ErrVolumeExpansionNotSupported is returned when a PVC is to be restored with a size larger than the restore size
+// of its snapshot, and its storage class does not allow volume expansion
+var ErrVolumeExpansionNotSupported = errors.New("volume expansion not supported by storage class")
+
+// ErrVolSyncTeardownIncomplete is returned while VolSync artifacts owned by the VRG remain after a teardown
+var ErrVolSyncTeardownIncomplete = errors.New("volsync teardown incomplete")
+
+// ErrSourcePVCMissing is returned when the source PVC of an existing ReplicationSource does not exist
+var ErrSourcePVCMissing = errors.New("source pvc missing")
+
+// ErrInvalidRestoreCapacity is returned when the restore capacity requested for a PVC cannot be parsed or is less
+// than the capacity requested by the PVC when it was protected
+var ErrInvalidRestoreCapacity = errors.New("invalid restore capacity")
+
+// ErrSnapshotRetained is returned when a VolumeSnapshot is not released for deletion, as its retention has not
+// expired
+var ErrSnapshotRetained = errors.New("snapshot retention not expired")
+
+// ErrPVCBelowMinSize is returned when a protected PVC requests less storage than the configured minimum size of
+// PVCs protected using VolSync, in which case no ReplicationSource or ReplicationDestination is created for it
+var ErrPVCBelowMinSize = errors.New("pvc size below minimum protected size")
+
+// ErrVolumeSnapshotClassDriverMismatch is returned when the VolumeSnapshotClass a storage class is annotated to use
+// has a driver other than the storage class provisioner
+var ErrVolumeSnapshotClassDriverMismatch = errors.New("volume snapshot class driver does not match storage provisioner")
+
+// ErrMoverServiceAccountMissing is returned when the service account configured for movers does not exist in the
+// namespace of a protected PVC
+var ErrMoverServiceAccountMissing = errors.New("mover service account missing")
+
+// ErrPVCTerminating is returned when a protected PVC, or its namespace, is being deleted, in which case the VolSync
+// artifacts for the PVC are not created or updated
+var ErrPVCTerminating = errors.New("pvc terminating")
+
+// ErrRequiredPVCLabelsMissing is returned when a restored PVC does not have all the labels required by the selectors
+// of the workload, e.g. as the PVC was already bound when it was restored, in which case the PVC is not restored yet
+var ErrRequiredPVCLabelsMissing = errors.New("required pvc labels missing")
+
+// ErrTriggerModeNotManual is returned when a sync is triggered manually while the ReplicationSources are synced on
+// their schedule, which would replace the manual trigger
+var ErrTriggerModeNotManual = errors.New("trigger mode not manual")
+
+// ErrFinalSyncTimedOut is returned when the final sync of a PVC has not completed within the final sync timeout of
+// its mover starting to sync, e.g. as the mover is stuck
+var ErrFinalSyncTimedOut = errors.New("final sync timed out")
+
 type VSHandler struct {
 	ctx                         context.Context
 	client                      client.Client
+	apiReader                   client.Reader // reads objects not watched, e.g. for one-off validations, uncached
 	log                         logr.Logger
 	owner                       metav1.Object
 	schedulingInterval          string
+	scheduleJitter              bool                 // offset the schedule of each PVC within the scheduling interval
 	volumeSnapshotClassSelector metav1.LabelSelector // volume snapshot classes to be filtered label selector
 	defaultCephFSCSIDriverName  string
 	destinationCopyMethod       volsyncv1alpha1.CopyMethodType
 	volumeSnapshotClassList     *snapv1.VolumeSnapshotClassList
+	// provisioner -> chosen volume snapshot class, computed from volumeSnapshotClassList on first lookup
+	volumeSnapshotClassByDriver map[string]*snapv1.VolumeSnapshotClass
 	vrgInAdminNamespace         bool
+	volSyncConfig               ramendrv1alpha1.VolSyncConfig
+	capabilities                *Capabilities // Do not detect until we need it
+	// restore PVCs with dataSourceRef rather than dataSource, if the cluster supports it
+	pvcDataSourceRefSupported bool
+	// namespaces in which VolSync movers were validated to have the permissions they need
+	moverRBACValidated map[string]bool
+	// earliest expiry of the retention of the snapshots kept by DeleteSnapshots
+	snapshotsRetainedUntil time.Time
+	// PVCs, by namespaced name, whose ReplicationDestinations are kept until the takeover as primary is confirmed
+	deferredRDs map[string]bool
+	// ReplicationSources, by namespaced name, holding a sync slot of the owner, nil if syncs are not capped
+	syncSlots        map[types.NamespacedName]bool
+	reconcileSummary ReconcileSummary
+	fieldManager     string
+	// labels required on restored PVCs by the selectors of the workload
+	requiredRestoredPVCLabels map[string]string
+	triggerMode               ramendrv1alpha1.VolSyncTriggerMode
 }
 
 func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, owner metav1.Object,
 	asyncSpec *ramendrv1alpha1.VRGAsyncSpec, defaultCephFSCSIDriverName string, copyMethod string,
-	adminNamespaceVRG bool,
+	adminNamespaceVRG bool, volSyncConfig *ramendrv1alpha1.VolSyncConfig,
 ) *VSHandler {
 	vsHandler := &VSHandler{
 		ctx:                        ctx,
 		client:                     client,
+		apiReader:                  client,
 		log:                        log,
 		owner:                      owner,
 		defaultCephFSCSIDriverName: defaultCephFSCSIDriverName,
 		destinationCopyMethod:      volsyncv1alpha1.CopyMethodType(copyMethod),
 		volumeSnapshotClassList:    nil, // Do not initialize until we need it
 		vrgInAdminNamespace:        adminNamespaceVRG,
+		moverRBACValidated:         map[string]bool{},
+		deferredRDs:                map[string]bool{},
+		fieldManager:               FieldManagerName,
 	}
 
 	if asyncSpec != nil {
 		vsHandler.schedulingInterval = asyncSpec.SchedulingInterval
+		vsHandler.scheduleJitter = asyncSpec.ScheduleJitter
 		vsHandler.volumeSnapshotClassSelector = asyncSpec.VolumeSnapshotClassSelector
 	}
 
+	if volSyncConfig != nil {
+		vsHandler.volSyncConfig = *volSyncConfig
+
+		if volSyncConfig.FieldManager != "" {
+			vsHandler.fieldManager = volSyncConfig.FieldManager
+		}
+	}
+
+	vsHandler.client = newFieldManagerClient(client, vsHandler.fieldManager)
+
 	return vsHandler
 }
 
+// PVCDataSourceRefMinVersion is the minimum Kubernetes version with the PVC dataSourceRef enabled by default
+const PVCDataSourceRefMinVersion = "1.24"
+
+// PVCDataSourceRefSupported returns true if the Kubernetes server gitVersion supports the PVC dataSourceRef
+func PVCDataSourceRefSupported(serverGitVersion string) bool {
+	serverVersion, err := version.ParseGeneric(serverGitVersion)
+	if err != nil {
+		return false
+	}
+
+	return serverVersion.AtLeast(version.MustParseGeneric(PVCDataSourceRefMinVersion))
+}
+
+// SetPVCDataSourceRefSupported sets whether PVCs are restored from snapshots using dataSourceRef, which newer
+// clusters prefer and may require, rather than dataSource
+func (v *VSHandler) SetPVCDataSourceRefSupported(supported bool) {
+	v.pvcDataSourceRefSupported = supported
+}
+
+// SetRequiredRestoredPVCLabels sets the labels the selectors of the workload require on its PVCs, which are set on the
+// PVCs restored from snapshots, in addition to the labels of the protected PVCs, and validated before a PVC restore is
+// complete
+func (v *VSHandler) SetRequiredRestoredPVCLabels(labels map[string]string) {
+	v.requiredRestoredPVCLabels = labels
+}
+
+// SetAPIReader sets the reader of the objects that are read once per reconcile rather than watched, e.g. the
+// namespaces and service accounts of the movers, which defaults to the client
+func (v *VSHandler) SetAPIReader(apiReader client.Reader) {
+	v.apiReader = apiReader
+}
+
+// SetTriggerMode sets how the syncs of the ReplicationSources are triggered, on the scheduling interval, or only on a
+// change of their manual trigger
+func (v *VSHandler) SetTriggerMode(triggerMode ramendrv1alpha1.VolSyncTriggerMode) {
+	v.triggerMode = triggerMode
+}
+
+// validateRequiredRestoredPVCLabels returns ErrRequiredPVCLabelsMissing, listing the missing labels, if the restored
+// PVC does not have all the required labels with their values
+func (v *VSHandler) validateRequiredRestoredPVCLabels(pvc *corev1.PersistentVolumeClaim) error {
+	missing := []string{}
+
+	for key, value := range v.requiredRestoredPVCLabels {
+		if actual, ok := pvc.GetLabels()[key]; !ok || actual != value {
+			missing = append(missing, key+"="+value)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+
+	return fmt.Errorf("%w, pvc: %s/%s, labels: %s", ErrRequiredPVCLabelsMissing, pvc.GetNamespace(), pvc.GetName(),
+		strings.Join(missing, ","))
+}
+
+// validatePVCSize returns ErrPVCBelowMinSize if the PVC requests less storage than the configured minimum size of
+// PVCs protected using VolSync. There is no minimum by default.
+func (v *VSHandler) validatePVCSize(protectedPVC ramendrv1alpha1.ProtectedPVC) error {
+	minSize := v.volSyncConfig.MinProtectedPVCSize
+	if minSize == nil {
+		return nil
+	}
+
+	size := protectedPVC.Resources.Requests.Storage()
+	if size.Cmp(*minSize) >= 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w, pvc: %s/%s, size: %s, minimum: %s", ErrPVCBelowMinSize,
+		protectedPVC.Namespace, protectedPVC.Name, size.String(), minSize.String())
+}
+
+// validatePVCNotTerminating returns ErrPVCTerminating if the namespace of the PVC, or the PVC if it exists, is being
+// deleted, so that the deletion is not fought by updating the PVC or creating objects in a namespace being deleted
+func (v *VSHandler) validatePVCNotTerminating(protectedPVC ramendrv1alpha1.ProtectedPVC) error {
+	ns := &corev1.Namespace{}
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: protectedPVC.Namespace}, ns); err != nil {
+		return fmt.Errorf("error getting namespace %s (%w)", protectedPVC.Namespace, err)
+	}
+
+	if !ns.GetDeletionTimestamp().IsZero() {
+		return fmt.Errorf("%w, namespace %s is being deleted", ErrPVCTerminating, protectedPVC.Namespace)
+	}
+
+	pvc, err := v.getPVC(util.ProtectedPVCNamespacedName(protectedPVC))
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !pvc.GetDeletionTimestamp().IsZero() {
+		return fmt.Errorf("%w, pvc %s/%s is being deleted", ErrPVCTerminating, protectedPVC.Namespace,
+			protectedPVC.Name)
+	}
+
+	return nil
+}
+
+// getMoverServiceAccount returns the name of the first of the service accounts configured for movers, the configured
+// name followed by its fallbacks, that exists in the namespace, or nil for movers to run as the service account
+// VolSync creates for them if none is configured
+func (v *VSHandler) getMoverServiceAccount(namespace string) (*string, error) {
+	candidates := v.volSyncConfig.MoverServiceAccountFallbackNames
+	if v.volSyncConfig.MoverServiceAccountName != "" {
+		candidates = append([]string{v.volSyncConfig.MoverServiceAccountName}, candidates...)
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	for _, saName := range candidates {
+		sa := &corev1.ServiceAccount{}
+		if err := v.apiReader.Get(v.ctx, types.NamespacedName{Name: saName, Namespace: namespace}, sa); err != nil {
+			if kerrors.IsNotFound(err) {
+				v.log.V(1).Info("Mover serviceaccount not found, trying the next candidate", "name", saName)
+
+				continue
+			}
+
+			return nil, fmt.Errorf("error getting mover serviceaccount %s/%s (%w)", namespace, saName, err)
+		}
+
+		return &saName, nil
+	}
+
+	return nil, fmt.Errorf("%w, serviceaccounts: %s/%v", ErrMoverServiceAccountMissing, namespace, candidates)
+}
+
 // returns replication destination only if create/update is successful and the RD is considered available.
 // Callers should assume getting a nil replication destination back means they should retry/requeue.
 //
@@ -111,22 +400,29 @@ func (v *VSHandler) ReconcileRD(
 		return nil, fmt.Errorf("protectedPVC %s is not VolSync Enabled", rdSpec.ProtectedPVC.Name)
 	}
 
+	v.resolveStorageClassAlias(&rdSpec.ProtectedPVC)
+
+	if err := v.validatePVCSize(rdSpec.ProtectedPVC); err != nil {
+		l.Info("Skipping ReplicationDestination", "reason", err.Error())
+
+		return nil, err
+	}
+
+	if err := v.ValidateMoverRBAC(rdSpec.ProtectedPVC.Namespace); err != nil {
+		return nil, err
+	}
+
+	if v.IsCopyMethodDirect() && !v.Capabilities().DirectCopy {
+		return nil, fmt.Errorf("copyMethod %s %w", v.destinationCopyMethod, ErrCapabilityNotSupported)
+	}
+
 	// Pre-allocated shared secret - DRPC will generate and propagate this secret from hub to clusters
 	pskSecretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
-	// Need to confirm this secret exists on the cluster before proceeding, otherwise volsync will generate it
-	secretExists, err := v.validateSecretAndAddVRGOwnerRef(pskSecretName)
+	secretExists, err := v.pskSecretStore().ensurePSKSecret(pskSecretName, rdSpec.ProtectedPVC.Namespace)
 	if err != nil || !secretExists {
 		return nil, err
 	}
 
-	if v.vrgInAdminNamespace {
-		// copy th secret to the namespace where the PVC is
-		err = v.copySecretToPVCNamespace(pskSecretName, util.ProtectedPVCNamespacedName(rdSpec.ProtectedPVC))
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	// Check if a ReplicationSource is still here (Can happen if transitioning from primary to secondary)
 	// Before creating a new RD for this PVC, make sure any ReplicationSource for this PVC is cleaned up first
 	// This avoids a scenario where we create an RD that immediately syncs with an RS that still exists locally
@@ -135,6 +431,12 @@ func (v *VSHandler) ReconcileRD(
 		return nil, err
 	}
 
+	// A ReplicationDestination kept paused while taking over as primary resumes if the takeover was rolled back
+	err = v.resumeDeferredRD(getReplicationDestinationName(rdSpec.ProtectedPVC.Name), rdSpec.ProtectedPVC.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	dstPVC, err := v.PrecreateDestPVCIfEnabled(rdSpec)
 	if err != nil {
 		return nil, err
@@ -185,11 +487,20 @@ func (v *VSHandler) createOrUpdateRD(
 ) {
 	l := v.log.WithValues("rdSpec", rdSpec)
 
+	if err := v.ValidateAccessModes(rdSpec.ProtectedPVC); err != nil {
+		return nil, err
+	}
+
 	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(rdSpec.ProtectedPVC.StorageClassName)
 	if err != nil {
 		return nil, err
 	}
 
+	moverServiceAccount, err := v.getMoverServiceAccount(rdSpec.ProtectedPVC.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	pvcAccessModes := []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce} // Default value
 	if len(rdSpec.ProtectedPVC.AccessModes) > 0 {
 		pvcAccessModes = rdSpec.ProtectedPVC.AccessModes
@@ -202,7 +513,7 @@ func (v *VSHandler) createOrUpdateRD(
 		},
 	}
 
-	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, rd, func() error {
+	mutateRD := func() error {
 		if !v.vrgInAdminNamespace {
 			if err := ctrl.SetControllerReference(v.owner, rd, v.client.Scheme()); err != nil {
 				l.Error(err, "unable to set controller reference")
@@ -213,12 +524,14 @@ func (v *VSHandler) createOrUpdateRD(
 
 		util.AddLabel(rd, VRGOwnerNameLabel, v.owner.GetName())
 		util.AddLabel(rd, VRGOwnerNamespaceLabel, v.owner.GetNamespace())
+		v.addReplicationAnnotations(rd)
 		util.AddAnnotation(rd, OwnerNameAnnotation, v.owner.GetName())
 		util.AddAnnotation(rd, OwnerNamespaceAnnotation, v.owner.GetNamespace())
 
 		rd.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
-			ServiceType: v.getRsyncServiceType(),
-			KeySecret:   &pskSecretName,
+			ServiceType:         v.getRsyncServiceType(),
+			KeySecret:           &pskSecretName,
+			MoverServiceAccount: moverServiceAccount,
 
 			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
@@ -231,11 +544,26 @@ func (v *VSHandler) createOrUpdateRD(
 		}
 
 		return nil
-	})
+	}
+
+	if v.volSyncConfig.ServerSideApply {
+		op, err := v.applyResource(rd, mutateRD)
+		if err != nil {
+			return nil, err
+		}
+
+		v.reconcileSummary.record(op)
+		l.V(1).Info("ReplicationDestination apply Complete", "op", op)
+
+		return rd, nil
+	}
+
+	op, err := v.createOrUpdate(rd, mutateRD)
 	if err != nil {
-		return nil, fmt.Errorf("%w", err)
+		return nil, err
 	}
 
+	v.reconcileSummary.record(op)
 	l.V(1).Info("ReplicationDestination createOrUpdate Complete", "op", op)
 
 	return rd, nil
@@ -285,29 +613,32 @@ func (v *VSHandler) ReconcileRS(rsSpec ramendrv1alpha1.VolSyncReplicationSourceS
 		return false, nil, fmt.Errorf("protectedPVC %s is not VolSync Enabled", rsSpec.ProtectedPVC.Name)
 	}
 
+	v.resolveStorageClassAlias(&rsSpec.ProtectedPVC)
+
+	if err := v.validatePVCSize(rsSpec.ProtectedPVC); err != nil {
+		l.Info("Skipping ReplicationSource", "reason", err.Error())
+
+		return false, nil, err
+	}
+
+	if err := v.validatePVCNotTerminating(rsSpec.ProtectedPVC); err != nil {
+		return v.reconcileRSOfTerminatingPVC(rsSpec, runFinalSync, err)
+	}
+
+	if err := v.ValidateMoverRBAC(rsSpec.ProtectedPVC.Namespace); err != nil {
+		return false, nil, err
+	}
+
 	// Pre-allocated shared secret - DRPC will generate and propagate this secret from hub to clusters
 	pskSecretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
 
-	// Need to confirm this secret exists on the cluster before proceeding, otherwise volsync will generate it
-	secretExists, err := v.validateSecretAndAddVRGOwnerRef(pskSecretName)
+	secretExists, err := v.pskSecretStore().ensurePSKSecret(pskSecretName, rsSpec.ProtectedPVC.Namespace)
 	if err != nil || !secretExists {
 		return false, nil, err
 	}
 
-	if v.vrgInAdminNamespace {
-		// copy th secret to the namespace where the PVC is
-		err = v.copySecretToPVCNamespace(pskSecretName, util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC))
-		if err != nil {
-			return false, nil, err
-		}
-	}
-
-	// Check if a ReplicationDestination is still here (Can happen if transitioning from secondary to primary)
-	// Before creating a new RS for this PVC, make sure any ReplicationDestination for this PVC is cleaned up first
-	// This avoids a scenario where we create an RS that immediately connects back to an RD that still exists locally
-	// Need to be sure ReconcileRS is never called prior to restoring any PVC that need to be restored from RDs first
-	err = v.DeleteRD(rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace)
-	if err != nil {
+	failbackReady, err := v.reconcileFailbackBeforeRS(rsSpec)
+	if !failbackReady || err != nil {
 		return false, nil, err
 	}
 
@@ -333,8 +664,14 @@ func (v *VSHandler) ReconcileRS(rsSpec ramendrv1alpha1.VolSyncReplicationSourceS
 	// For final sync only - check status to make sure the final sync is complete
 	// and also run cleanup (removes PVC we just ran the final sync from)
 	//
-	if runFinalSync && isFinalSyncComplete(replicationSource, l) {
-		return true, replicationSource, v.cleanupAfterRSFinalSync(rsSpec)
+	if runFinalSync {
+		if isFinalSyncComplete(replicationSource, l) {
+			return true, replicationSource, v.cleanupAfterRSFinalSync(rsSpec)
+		}
+
+		if err := v.validateFinalSyncNotTimedOut(replicationSource); err != nil {
+			return false, replicationSource, err
+		}
 	}
 
 	l.V(1).Info("ReplicationSource Reconcile Complete")
@@ -342,6 +679,133 @@ func (v *VSHandler) ReconcileRS(rsSpec ramendrv1alpha1.VolSyncReplicationSourceS
 	return false, replicationSource, err
 }
 
+// reconcileRSOfTerminatingPVC does not reconcile the ReplicationSource of a PVC that, or whose namespace, is being
+// deleted, unless the PVC is being deleted after its final sync completed, in which case the final sync is reported
+// complete.
+func (v *VSHandler) reconcileRSOfTerminatingPVC(rsSpec ramendrv1alpha1.VolSyncReplicationSourceSpec,
+	runFinalSync bool, terminatingErr error) (bool /* finalSyncComplete */, *volsyncv1alpha1.ReplicationSource, error,
+) {
+	l := v.log.WithValues("pvcName", rsSpec.ProtectedPVC.Name, "pvcNamespace", rsSpec.ProtectedPVC.Namespace)
+
+	if runFinalSync && errors.Is(terminatingErr, ErrPVCTerminating) {
+		rs, err := v.getRS(getReplicationSourceName(rsSpec.ProtectedPVC.Name), rsSpec.ProtectedPVC.Namespace)
+		if err == nil && isFinalSyncComplete(rs, l) {
+			return true, rs, v.cleanupAfterRSFinalSync(rsSpec)
+		}
+	}
+
+	l.Info("Not reconciling ReplicationSource", "reason", terminatingErr.Error())
+
+	return false, nil, terminatingErr
+}
+
+// reconcileFailbackBeforeRS orders the secondary to primary transition for a PVC. A ReplicationDestination may still
+// be here when transitioning from secondary to primary. Before creating a new RS for this PVC, the RD is deleted and
+// confirmed gone, and the restored PVC is confirmed bound. This avoids a scenario where we create an RS that
+// immediately connects back to an RD that still exists locally (or is still being deleted). An RD whose cleanup is
+// deferred is kept, paused, alongside the RS instead, as the takeover is only confirmed once the RS replicates.
+// A restored PVC of a WaitForFirstConsumer storage class stays Pending until the application pod using it is
+// scheduled, so such a PVC is accepted as Pending. The RS is still not created until the PVC is in use by a ready
+// pod, see validatePVCBeforeRS, so the mover pod is never the first consumer of the PVC.
+// Need to be sure ReconcileRS is never called prior to restoring any PVC that need to be restored from RDs first.
+// Returns true once it is safe to create or update the RS.
+func (v *VSHandler) reconcileFailbackBeforeRS(rsSpec ramendrv1alpha1.VolSyncReplicationSourceSpec) (bool, error) {
+	l := v.log.WithValues("pvcName", rsSpec.ProtectedPVC.Name, "pvcNamespace", rsSpec.ProtectedPVC.Namespace)
+
+	rdDeleted, err := v.deleteRDBeforeRS(rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace)
+	if !rdDeleted || err != nil {
+		return false, err
+	}
+
+	_, err = v.getRS(getReplicationSourceName(rsSpec.ProtectedPVC.Name), rsSpec.ProtectedPVC.Namespace)
+	if err == nil {
+		// RS already exists, the transition is done
+		return true, nil
+	}
+
+	if !kerrors.IsNotFound(err) {
+		return false, err
+	}
+
+	pvc, err := v.getPVC(util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC))
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			l.Info("Waiting for PVC to be restored before creating RS")
+
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if pvc.Status.Phase != corev1.ClaimBound {
+		waitsForFirstConsumer, err := v.pvcWaitsForFirstConsumer(pvc)
+		if err != nil {
+			return false, err
+		}
+
+		if waitsForFirstConsumer {
+			l.Info("PVC is pending until its first consumer is scheduled", "phase", pvc.Status.Phase)
+
+			return true, nil
+		}
+
+		l.Info("Waiting for PVC to be bound before creating RS", "phase", pvc.Status.Phase)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// deleteRDBeforeRS deletes the ReplicationDestination of the PVC, unless its cleanup is deferred, and returns true
+// once it is gone, or kept as deferred
+func (v *VSHandler) deleteRDBeforeRS(pvcName, pvcNamespace string) (bool, error) {
+	rd, err := v.getRD(pvcName, pvcNamespace)
+	if err != nil {
+		return false, err
+	}
+
+	if rd != nil && v.deferredRDs[pvcNamespace+"/"+pvcName] {
+		v.log.V(1).Info("Keeping ReplicationDestination until the takeover is confirmed", "rdName", rd.GetName())
+
+		return true, nil
+	}
+
+	if err := v.DeleteRD(pvcName, pvcNamespace); err != nil {
+		return false, err
+	}
+
+	rd, err = v.getRD(pvcName, pvcNamespace)
+	if err != nil {
+		return false, err
+	}
+
+	if rd != nil {
+		v.log.Info("Waiting for ReplicationDestination to be deleted before creating RS", "rdName", rd.GetName())
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// pvcWaitsForFirstConsumer returns true if the PVC is Pending only as its storage class has a volume binding mode of
+// WaitForFirstConsumer, i.e. the PVC is bound once a pod using it is scheduled
+func (v *VSHandler) pvcWaitsForFirstConsumer(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Status.Phase != corev1.ClaimPending || pvc.Spec.VolumeName != "" || pvc.Spec.StorageClassName == nil {
+		return false, nil
+	}
+
+	storageClass, err := v.getStorageClass(pvc.Spec.StorageClassName)
+	if err != nil {
+		return false, err
+	}
+
+	return storageClass.VolumeBindingMode != nil &&
+		*storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer, nil
+}
+
 // Need to validate that our PVC is no longer in use before proceeding
 // If in final sync and the source PVC no longer exists, this could be from
 // a 2nd call to runFinalSync and we may have already cleaned up the PVC - so if pvc does not
@@ -361,7 +825,9 @@ func (v *VSHandler) validatePVCBeforeRS(rsSpec ramendrv1alpha1.VolSyncReplicatio
 		}
 
 		if pvcIsMounted {
-			return false, nil
+			_, err := v.QuiescePVCWorkloads(util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC))
+
+			return false, err
 		}
 
 		return true, nil // Good to proceed - PVC is not in use, not mounted to node (or does not exist-should not happen)
@@ -397,10 +863,84 @@ func (v *VSHandler) validatePVCBeforeRS(rsSpec ramendrv1alpha1.VolSyncReplicatio
 		return false, err
 	}
 
-	// Replication source already exists, no need for any pvc checking
+	// Replication source already exists, no need for any pvc checking other than that it still exists
+	if _, err := v.getPVC(util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC)); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, err
+		}
+
+		return false, v.handleSourcePVCMissing(util.ProtectedPVCNamespacedName(rsSpec.ProtectedPVC))
+	}
+
 	return true, nil
 }
 
+// handleSourcePVCMissing waits for the missing source PVC of an existing ReplicationSource to be recreated, or
+// deletes the ReplicationSource, as configured. Returns an error wrapping ErrSourcePVCMissing either way.
+func (v *VSHandler) handleSourcePVCMissing(pvcNamespacedName types.NamespacedName) error {
+	missingErr := fmt.Errorf("%w, pvc: %s", ErrSourcePVCMissing, pvcNamespacedName.String())
+
+	if v.volSyncConfig.SourcePVCMissingAction != SourcePVCMissingActionDelete {
+		v.log.Info("Source PVC missing, waiting for it to be recreated", "pvcName", pvcNamespacedName.Name)
+
+		return missingErr
+	}
+
+	v.log.Info("Source PVC missing, deleting ReplicationSource", "pvcName", pvcNamespacedName.Name)
+
+	if err := v.DeleteRS(pvcNamespacedName.Name, pvcNamespacedName.Namespace); err != nil {
+		return err
+	}
+
+	return missingErr
+}
+
+// HandleMissingSourcePVCs handles the owned ReplicationSources, other than those of the listed PVCs, whose source PVC
+// is missing, as configured, see handleSourcePVCMissing. Such PVCs are no longer listed for protection, so their
+// ReplicationSources are not otherwise reconciled. ReplicationSources that completed their final sync, and the local
+// ReplicationSource of the Direct copy method, are expected to outlive their source PVC, and are not handled. It
+// returns the missing source PVCs.
+func (v *VSHandler) HandleMissingSourcePVCs(pvcs []types.NamespacedName) ([]types.NamespacedName, error) {
+	rsList, err := v.listRSByOwner("")
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[types.NamespacedName]bool, len(pvcs))
+	for _, pvc := range pvcs {
+		listed[pvc] = true
+	}
+
+	missing := []types.NamespacedName{}
+
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+
+		pvcNamespacedName := types.NamespacedName{Name: rs.Spec.SourcePVC, Namespace: rs.GetNamespace()}
+		if listed[pvcNamespacedName] || isLocalRS(rs) || util.ResourceIsDeleted(rs) ||
+			(rs.Status != nil && rs.Status.LastManualSync == FinalSyncTriggerString) {
+			continue
+		}
+
+		_, err := v.getPVC(pvcNamespacedName)
+		if err == nil {
+			continue
+		}
+
+		if !kerrors.IsNotFound(err) {
+			return missing, err
+		}
+
+		if err := v.handleSourcePVCMissing(pvcNamespacedName); !errors.Is(err, ErrSourcePVCMissing) {
+			return missing, err
+		}
+
+		missing = append(missing, pvcNamespacedName)
+	}
+
+	return missing, nil
+}
+
 func isFinalSyncComplete(replicationSource *volsyncv1alpha1.ReplicationSource, log logr.Logger) bool {
 	if replicationSource.Status == nil || replicationSource.Status.LastManualSync != FinalSyncTriggerString {
 		log.V(1).Info("ReplicationSource running final sync - waiting for status ...")
@@ -413,6 +953,39 @@ func isFinalSyncComplete(replicationSource *volsyncv1alpha1.ReplicationSource, l
 	return true
 }
 
+// validateFinalSyncNotTimedOut returns ErrFinalSyncTimedOut, with the latest status of the mover, if the mover of the
+// ReplicationSource has been syncing for longer than the final sync timeout. VolSync resets the start time of a sync
+// once it completes, so a mover waiting to start the final sync is not timed out.
+func (v *VSHandler) validateFinalSyncNotTimedOut(rs *volsyncv1alpha1.ReplicationSource) error {
+	if rs.Status == nil || rs.Status.LastSyncStartTime == nil {
+		return nil
+	}
+
+	timeout := DefaultFinalSyncTimeout
+	if v.volSyncConfig.FinalSyncTimeoutSeconds > 0 {
+		timeout = time.Duration(v.volSyncConfig.FinalSyncTimeoutSeconds) * time.Second
+	}
+
+	if time.Since(rs.Status.LastSyncStartTime.Time) < timeout {
+		return nil
+	}
+
+	moverResult := "unknown"
+	moverLogs := ""
+
+	if rs.Status.LatestMoverStatus != nil {
+		moverResult = string(rs.Status.LatestMoverStatus.Result)
+		moverLogs = rs.Status.LatestMoverStatus.Logs
+	}
+
+	v.log.Info("Final sync timed out", "rsName", rs.GetName(), "startTime", rs.Status.LastSyncStartTime,
+		"timeout", timeout, "moverResult", moverResult, "moverLogs", moverLogs)
+
+	return fmt.Errorf("%w, final sync of ReplicationSource %s/%s started at %s did not complete within %s,"+
+		" latest mover result: %s", ErrFinalSyncTimedOut, rs.GetNamespace(), rs.GetName(),
+		rs.Status.LastSyncStartTime.UTC().Format(time.RFC3339), timeout, moverResult)
+}
+
 func (v *VSHandler) cleanupAfterRSFinalSync(rsSpec ramendrv1alpha1.VolSyncReplicationSourceSpec) error {
 	// Final sync is done, make sure PVC is cleaned up, Skip if we are using CopyMethodDirect
 	if v.IsCopyMethodDirect() {
@@ -423,6 +996,11 @@ func (v *VSHandler) cleanupAfterRSFinalSync(rsSpec ramendrv1alpha1.VolSyncReplic
 
 	v.log.Info("Cleanup after final sync", "pvcName", rsSpec.ProtectedPVC.Name)
 
+	if v.volSyncConfig.VerifyPVCDeletion {
+		return util.DeletePVCAndVerify(v.ctx, v.client, rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace,
+			v.log)
+	}
+
 	return util.DeletePVC(v.ctx, v.client, rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace, v.log)
 }
 
@@ -459,7 +1037,27 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 		},
 	}
 
-	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, rs, func() error {
+	manualTriggerMode := v.triggerMode == ramendrv1alpha1.VolSyncTriggerModeManual
+	manualTrigger := ""
+
+	if !runFinalSync && manualTriggerMode {
+		manualTrigger, err = v.currentManualTrigger(rs.GetName(), rs.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	initialSync := false
+	if !runFinalSync && !manualTriggerMode && v.volSyncConfig.InitialSyncImmediate {
+		initialSync, err = v.initialSyncPending(rs.GetName(), rs.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	throttled := !runFinalSync && v.syncThrottled(rs.GetName(), rs.GetNamespace())
+
+	mutateRS := func() error {
 		if !v.vrgInAdminNamespace {
 			if err := ctrl.SetControllerReference(v.owner, rs, v.client.Scheme()); err != nil {
 				l.Error(err, "unable to set controller reference")
@@ -470,8 +1068,11 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 
 		util.AddLabel(rs, VRGOwnerNameLabel, v.owner.GetName())
 		util.AddLabel(rs, VRGOwnerNamespaceLabel, v.owner.GetNamespace())
+		v.addReplicationAnnotations(rs)
 
 		rs.Spec.SourcePVC = rsSpec.ProtectedPVC.Name
+		// Paused until a sync slot of the owner frees up, if the concurrent syncs of the owner are capped
+		mutateRSSyncThrottle(rs, throttled)
 
 		if runFinalSync {
 			l.V(1).Info("ReplicationSource - final sync")
@@ -480,9 +1081,24 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 			rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{
 				Manual: FinalSyncTriggerString,
 			}
+		} else if manualTriggerMode {
+			// Keep the current manual trigger, so that a sync runs only when it is changed. VolSync runs the first
+			// sync of a ReplicationSource on its creation regardless of its trigger.
+			if manualTrigger == "" {
+				manualTrigger = InitialSyncTriggerString
+			}
+			rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+				Manual: manualTrigger,
+			}
+		} else if initialSync {
+			l.V(1).Info("ReplicationSource - initial sync")
+			// Trigger the first sync right away, rather than on the next tick of the schedule
+			rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+				Manual: InitialSyncTriggerString,
+			}
 		} else {
 			// Set schedule
-			scheduleCronSpec, err := v.getScheduleCronSpec()
+			scheduleCronSpec, err := v.getScheduleCronSpec(rsSpec.ProtectedPVC.Name)
 			if err != nil {
 				l.Error(err, "unable to parse schedulingInterval")
 
@@ -493,29 +1109,355 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 			}
 		}
 
-		rs.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
-			KeySecret: &pskSecretName,
-			Address:   &remoteAddress,
+		rs.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
+			KeySecret: &pskSecretName,
+			Address:   &remoteAddress,
+
+			ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+				// Always using CopyMethod of snapshot for now - could use 'Clone' CopyMethod for specific
+				// storage classes that support it in the future
+				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
+				VolumeSnapshotClassName: &volumeSnapshotClassName,
+				StorageClassName:        rsSpec.ProtectedPVC.StorageClassName,
+				AccessModes:             rsSpec.ProtectedPVC.AccessModes,
+			},
+		}
+
+		return nil
+	}
+
+	if v.volSyncConfig.ServerSideApply {
+		op, err := v.applyResource(rs, mutateRS)
+		if err != nil {
+			return nil, err
+		}
+
+		v.reconcileSummary.record(op)
+		l.V(1).Info("ReplicationSource apply Complete", "op", op)
+
+		return rs, nil
+	}
+
+	op, err := v.createOrUpdate(rs, mutateRS)
+	if err != nil {
+		return nil, err
+	}
+
+	v.reconcileSummary.record(op)
+	l.V(1).Info("ReplicationSource createOrUpdate Complete", "op", op)
+
+	return rs, nil
+}
+
+// initialSyncPending returns true if the ReplicationSource is to be created, or if its initial sync was triggered and
+// has not completed yet, in which case its first sync is triggered manually. It switches to its schedule once the
+// initial sync completes.
+func (v *VSHandler) initialSyncPending(rsName, rsNamespace string) (bool, error) {
+	rs, err := v.getRS(rsName, rsNamespace)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	if rs.Spec.Trigger == nil || rs.Spec.Trigger.Manual != InitialSyncTriggerString {
+		return false, nil
+	}
+
+	return rs.Status == nil || rs.Status.LastManualSync != InitialSyncTriggerString, nil
+}
+
+// currentManualTrigger returns the manual trigger of the ReplicationSource, or an empty string if it does not exist or
+// is not triggered manually
+func (v *VSHandler) currentManualTrigger(rsName, rsNamespace string) (string, error) {
+	rs, err := v.getRS(rsName, rsNamespace)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	if rs.Spec.Trigger == nil {
+		return "", nil
+	}
+
+	return rs.Spec.Trigger.Manual, nil
+}
+
+// TriggerManualSync triggers a sync of the ReplicationSource of the PVC owned by the owner, by setting its manual
+// trigger to the given value, if the trigger mode is manual. The sync runs if the value differs from that of the most
+// recent manual sync, as the ReplicationSource status reports it.
+func (v *VSHandler) TriggerManualSync(pvcName, pvcNamespace, trigger string) error {
+	if v.triggerMode != ramendrv1alpha1.VolSyncTriggerModeManual {
+		return fmt.Errorf("%w, unable to trigger a sync of pvc %s/%s", ErrTriggerModeNotManual, pvcNamespace, pvcName)
+	}
+
+	rs, err := v.getRS(getReplicationSourceName(pvcName), pvcNamespace)
+	if err != nil {
+		return err
+	}
+
+	if !util.HasLabelWithValue(rs, VRGOwnerNameLabel, v.owner.GetName()) ||
+		!util.HasLabelWithValue(rs, VRGOwnerNamespaceLabel, v.owner.GetNamespace()) {
+		return fmt.Errorf("replicationSource %s is not owned by this VRG", rs.GetName())
+	}
+
+	if rs.Spec.Trigger != nil && rs.Spec.Trigger.Manual == trigger {
+		return nil
+	}
+
+	rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+		Manual: trigger,
+	}
+
+	if err := v.client.Update(v.ctx, rs); err != nil {
+		return fmt.Errorf("failed to trigger a sync of ReplicationSource %s (%w)", rs.GetName(), err)
+	}
+
+	v.log.Info("Triggered a manual sync of ReplicationSource", "name", rs.GetName(), "trigger", trigger)
+
+	return nil
+}
+
+// addReplicationAnnotations adds the configured ReplicationAnnotations to a ReplicationSource or
+// ReplicationDestination, except for those reserved for Ramen
+func (v *VSHandler) addReplicationAnnotations(obj client.Object) {
+	for key, value := range v.volSyncConfig.ReplicationAnnotations {
+		if strings.HasPrefix(key, ramenAnnotationPrefix) {
+			continue
+		}
+
+		util.AddAnnotation(obj, key, value)
+	}
+}
+
+// applyResource server-side applies the fields set by mutate on obj, using the ramen-volsync field manager.
+// Unlike CreateOrUpdate, obj is not mutated from the object read from the API server, so it must only contain the
+// fields owned by Ramen. Fields set by others, such as VolSync, are left untouched. On success obj is updated with
+// the applied resource as returned by the API server, and the operation result is determined from the object read
+// before the apply.
+func (v *VSHandler) applyResource(obj client.Object, mutate func() error) (ctrlutil.OperationResult, error) {
+	gvk, err := apiutil.GVKForObject(obj, v.client.Scheme())
+	if err != nil {
+		return ctrlutil.OperationResultNone, fmt.Errorf("%w", err)
+	}
+
+	existing, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return ctrlutil.OperationResultNone, fmt.Errorf("unable to copy %s", getKindAndName(v.client.Scheme(), obj))
+	}
+
+	if err := v.client.Get(v.ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return ctrlutil.OperationResultNone, fmt.Errorf("failed to get %s (%w)",
+				getKindAndName(v.client.Scheme(), obj), err)
+		}
+
+		existing = nil
+	}
+
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if err := mutate(); err != nil {
+		return ctrlutil.OperationResultNone, err
+	}
+
+	if err := v.client.Patch(v.ctx, obj, client.Apply, client.FieldOwner(v.fieldManager),
+		client.ForceOwnership); err != nil {
+		return ctrlutil.OperationResultNone, fmt.Errorf("failed to apply %s (%w)",
+			getKindAndName(v.client.Scheme(), obj), err)
+	}
+
+	return applyOperationResult(existing, obj), nil
+}
+
+// createOrUpdate creates obj, or updates it with mutate if it exists, as controllerutil.CreateOrUpdate does, except
+// that the update is skipped if the fields Ramen owns, the spec, labels, annotations and owner references, are
+// unchanged by mutate, so that reconciling an unchanged object does not write it, and does not bump its
+// resourceVersion to retrigger watches. The status, set by VolSync, is not compared.
+func (v *VSHandler) createOrUpdate(obj client.Object, mutate func() error) (ctrlutil.OperationResult, error) {
+	if err := v.client.Get(v.ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return ctrlutil.OperationResultNone, fmt.Errorf("failed to get %s (%w)",
+				getKindAndName(v.client.Scheme(), obj), err)
+		}
+
+		if err := mutate(); err != nil {
+			return ctrlutil.OperationResultNone, err
+		}
+
+		if err := v.client.Create(v.ctx, obj); err != nil {
+			return ctrlutil.OperationResultNone, fmt.Errorf("failed to create %s (%w)",
+				getKindAndName(v.client.Scheme(), obj), err)
+		}
+
+		return ctrlutil.OperationResultCreated, nil
+	}
+
+	existing, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return ctrlutil.OperationResultNone, fmt.Errorf("failed to copy %s", getKindAndName(v.client.Scheme(), obj))
+	}
+
+	if err := mutate(); err != nil {
+		return ctrlutil.OperationResultNone, err
+	}
+
+	if ramenOwnedFieldsEqual(obj, existing) {
+		return ctrlutil.OperationResultNone, nil
+	}
+
+	if err := v.client.Update(v.ctx, obj); err != nil {
+		return ctrlutil.OperationResultNone, fmt.Errorf("failed to update %s (%w)",
+			getKindAndName(v.client.Scheme(), obj), err)
+	}
+
+	return ctrlutil.OperationResultUpdated, nil
+}
+
+// ramenOwnedFieldsEqual returns true if the spec, labels, annotations and owner references of the objects are equal.
+// Zero values are compared too, so that a field cleared by Ramen is written.
+func ramenOwnedFieldsEqual(a, b client.Object) bool {
+	return equality.Semantic.DeepEqual(a.GetLabels(), b.GetLabels()) &&
+		equality.Semantic.DeepEqual(a.GetAnnotations(), b.GetAnnotations()) &&
+		equality.Semantic.DeepEqual(a.GetOwnerReferences(), b.GetOwnerReferences()) &&
+		equality.Semantic.DeepEqual(objectSpec(a), objectSpec(b))
+}
+
+// objectSpec returns the Spec field of the object, or nil if it has none
+func objectSpec(obj client.Object) interface{} {
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	spec := value.Elem().FieldByName("Spec")
+	if !spec.IsValid() {
+		return nil
+	}
+
+	return spec.Interface()
+}
+
+// ApplyScheduleToAll updates the trigger schedule of every ReplicationSource owned by the owner to the given
+// scheduling interval at once, instead of as each one is reconciled. ReplicationSources holding a manual trigger,
+// e.g. of an initial or a final sync, are skipped, to be switched to the schedule as they are reconciled. Returns the
+// namespaced names of the ReplicationSources that have converged to the new schedule, and of those skipped. The
+// ReplicationSources that fail to be updated do not stop the others from being updated, and the combined error of
+// their failures is returned.
+func (v *VSHandler) ApplyScheduleToAll(interval string) (converged, skipped []string, err error) {
+	if _, err := ConvertSchedulingIntervalToCronSpec(interval); err != nil {
+		return nil, nil, err
+	}
+
+	v.schedulingInterval = interval
+
+	// Owned ReplicationSources are in the PVC namespaces, which may differ from the owner namespace
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	converged = []string{}
+	skipped = []string{}
+	errs := []error{}
+
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		rsName := client.ObjectKeyFromObject(rs).String()
+
+		applied, err := v.applyScheduleToRS(rs)
+
+		switch {
+		case err != nil:
+			v.log.Error(err, "Failed to update ReplicationSource schedule", "name", rsName)
+
+			errs = append(errs, fmt.Errorf("ReplicationSource %s (%w)", rsName, err))
+		case !applied:
+			skipped = append(skipped, rsName)
+		default:
+			converged = append(converged, rsName)
+		}
+	}
+
+	v.log.Info("Applied schedule to ReplicationSources", "schedulingInterval", interval, "converged", converged,
+		"skipped", skipped, "failed", len(errs))
+
+	if len(errs) != 0 {
+		return converged, skipped, fmt.Errorf("scheduling interval %s not applied to all ReplicationSources (%w)",
+			interval, errors.Join(errs...))
+	}
+
+	return converged, skipped, nil
+}
+
+// applyScheduleToRS updates the trigger schedule of the ReplicationSource, retrying on conflicts with its concurrent
+// updates, e.g. by VolSync. Returns false if the ReplicationSource is skipped, as it holds a manual trigger.
+func (v *VSHandler) applyScheduleToRS(rs *volsyncv1alpha1.ReplicationSource) (applied bool, err error) {
+	cronSpec, err := v.getScheduleCronSpec(rs.Spec.SourcePVC)
+	if err != nil {
+		return false, err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := v.client.Get(v.ctx, client.ObjectKeyFromObject(rs), rs); err != nil {
+			return err
+		}
+
+		if rs.Spec.Trigger != nil && rs.Spec.Trigger.Manual != "" {
+			v.log.Info("ReplicationSource is running a manual sync, not updating its schedule",
+				"name", client.ObjectKeyFromObject(rs).String(), "trigger", rs.Spec.Trigger.Manual)
+
+			applied = false
+
+			return nil
+		}
+
+		applied = true
 
-			ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
-				// Always using CopyMethod of snapshot for now - could use 'Clone' CopyMethod for specific
-				// storage classes that support it in the future
-				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
-				VolumeSnapshotClassName: &volumeSnapshotClassName,
-				StorageClassName:        rsSpec.ProtectedPVC.StorageClassName,
-				AccessModes:             rsSpec.ProtectedPVC.AccessModes,
-			},
+		if rs.Spec.Trigger != nil && rs.Spec.Trigger.Schedule != nil && *rs.Spec.Trigger.Schedule == *cronSpec {
+			return nil
 		}
 
-		return nil
+		rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+			Schedule: cronSpec,
+		}
+
+		return v.client.Update(v.ctx, rs)
 	})
+
+	return applied, err
+}
+
+// EffectiveSchedule returns the cronspec on the owned ReplicationSource of the PVC, which is the schedule VolSync is
+// actually running. An empty cronspec is returned if there is no owned ReplicationSource, or if it is running a final
+// sync and hence has no schedule.
+func (v *VSHandler) EffectiveSchedule(pvcName, pvcNamespace string) (string, error) {
+	rs, err := v.getRS(getReplicationSourceName(pvcName), pvcNamespace)
 	if err != nil {
-		return nil, fmt.Errorf("%w", err)
+		if kerrors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
 	}
 
-	l.V(1).Info("ReplicationSource createOrUpdate Complete", "op", op)
+	if !util.HasLabelWithValue(rs, VRGOwnerNameLabel, v.owner.GetName()) ||
+		!util.HasLabelWithValue(rs, VRGOwnerNamespaceLabel, v.owner.GetNamespace()) {
+		v.log.Info("ReplicationSource is not owned by this VRG, no effective schedule", "name", rs.GetName())
 
-	return rs, nil
+		return "", nil
+	}
+
+	if rs.Spec.Trigger == nil || rs.Spec.Trigger.Schedule == nil {
+		return "", nil
+	}
+
+	return *rs.Spec.Trigger.Schedule, nil
 }
 
 func (v *VSHandler) PreparePVC(pvcNamespacedName types.NamespacedName, prepFinalSync, copyMethodDirect bool) error {
@@ -573,12 +1515,21 @@ func (v *VSHandler) pvcExistsAndInUse(pvcNamespacedName types.NamespacedName, in
 
 	log.V(1).Info("pvc found")
 
-	inUseByPod, err := util.IsPVCInUseByPod(v.ctx, v.client, v.log, pvcNamespacedName, inUsePodMustBeReady)
+	inUseByPod, mountedByExcludedPod, err := util.IsPVCInUseByNonExcludedPod(v.ctx, v.client, v.log,
+		pvcNamespacedName, inUsePodMustBeReady, v.volSyncConfig.InUseCheckExclusions)
 	if err != nil || inUseByPod || inUsePodMustBeReady {
 		// Return status immediately
 		return inUseByPod, err
 	}
 
+	if mountedByExcludedPod {
+		// The PV remains attached to a node on behalf of the excluded (infrastructure) pods, so the
+		// volume attachment check would always report it in use
+		log.Info("pvc is mounted only by pods excluded from the in-use check, assuming not in-use")
+
+		return false, nil
+	}
+
 	// No pod is mounting the PVC - do additional check to make sure no volume attachment exists
 	return util.IsPVAttachedToNode(v.ctx, v.client, v.log, pvc)
 }
@@ -641,8 +1592,13 @@ func (v *VSHandler) validateSecretAndAddVRGOwnerRef(secretName string) (bool, er
 
 	v.log.Info("Secret exists", "secretName", secretName)
 
-	// Add VRG as owner
-	if err := v.addOwnerReferenceAndUpdate(secret, v.owner); err != nil {
+	// Add VRG, or the configured secret owner, as owner
+	owner, err := v.pskSecretOwner()
+	if err != nil {
+		return true, err
+	}
+
+	if err := v.addOwnerReferenceAndUpdate(secret, owner); err != nil {
 		v.log.Error(err, "Unable to update secret", "secretName", secretName)
 
 		return true, err
@@ -653,13 +1609,123 @@ func (v *VSHandler) validateSecretAndAddVRGOwnerRef(secretName string) (bool, er
 	return true, nil
 }
 
-func (v *VSHandler) copySecretToPVCNamespace(secretName string, pvcNamespacedName types.NamespacedName) error {
+// EnsureSecretOwnership adds the owner's, or the configured psk secret owner's, reference on the VolSync psk secret,
+// without reconciling any ReplicationSource or ReplicationDestination, e.g. after the secret is rotated on the hub.
+// It returns false if the secret does not exist.
+func (v *VSHandler) EnsureSecretOwnership() (bool, error) {
+	return v.validateSecretAndAddVRGOwnerRef(GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName()))
+}
+
+// ReleaseVolSyncSecret releases the owner's reference on the VolSync psk secret, which is shared by every owner
+// that has added itself as an owner of it. The ReplicationSources and ReplicationDestinations of other owners using
+// the secret as their key secret are added as owners of it, and the owner reference is removed only if another owner
+// reference remains, so that deleting this owner leaves the secret for them. Otherwise the reference is kept, so that
+// the secret is garbage collected along with this owner, once any finalizer on it is removed.
+func (v *VSHandler) ReleaseVolSyncSecret() error {
+	secretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+	secret := &corev1.Secret{}
+
+	err := v.client.Get(v.ctx,
+		types.NamespacedName{
+			Name:      secretName,
+			Namespace: v.owner.GetNamespace(),
+		}, secret)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		v.log.Error(err, "Failed to get secret", "secretName", secretName)
+
+		return fmt.Errorf("error getting secret (%w)", err)
+	}
+
+	if !isOwnedBy(secret, v.owner) {
+		return nil
+	}
+
+	users, err := v.listVolSyncSecretUsers(secret)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if err := ctrlutil.SetOwnerReference(user, secret, v.client.Scheme()); err != nil {
+			return fmt.Errorf("failed to add owner reference of %s to secret %s (%w)",
+				getKindAndName(v.client.Scheme(), user), secretName, err)
+		}
+	}
+
+	otherOwnerRefs := []metav1.OwnerReference{}
+
+	for _, ownerRef := range secret.GetOwnerReferences() {
+		if ownerRef.UID != v.owner.GetUID() {
+			otherOwnerRefs = append(otherOwnerRefs, ownerRef)
+		}
+	}
+
+	if len(otherOwnerRefs) == 0 {
+		// Held by this owner only - leave the secret to be garbage collected with the owner
+		return nil
+	}
+
+	secret.SetOwnerReferences(otherOwnerRefs)
+
+	if err := v.client.Update(v.ctx, secret); err != nil {
+		v.log.Error(err, "Failed to remove owner reference from secret", "secretName", secretName)
+
+		return fmt.Errorf("failed to remove owner reference from secret %s (%w)", secretName, err)
+	}
+
+	v.log.Info("Released VolSync secret, still owned by others", "secretName", secretName,
+		"owners", len(otherOwnerRefs), "users", len(users))
+
+	return nil
+}
+
+// listVolSyncSecretUsers returns the ReplicationSources and ReplicationDestinations in the namespace of the secret,
+// of owners other than this one, that use the secret as their key secret
+func (v *VSHandler) listVolSyncSecretUsers(secret *corev1.Secret) ([]client.Object, error) {
+	usesSecret := func(obj metav1.Object, rsyncTLSKeySecret *string) bool {
+		return (obj.GetLabels()[VRGOwnerNameLabel] != v.owner.GetName() ||
+			obj.GetLabels()[VRGOwnerNamespaceLabel] != v.owner.GetNamespace()) &&
+			rsyncTLSKeySecret != nil && *rsyncTLSKeySecret == secret.GetName()
+	}
+
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	if err := v.client.List(v.ctx, rsList, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("error listing ReplicationSources using secret %s (%w)", secret.GetName(), err)
+	}
+
+	rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+	if err := v.client.List(v.ctx, rdList, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("error listing ReplicationDestinations using secret %s (%w)", secret.GetName(), err)
+	}
+
+	users := []client.Object{}
+
+	for i := range rsList.Items {
+		if rs := &rsList.Items[i]; rs.Spec.RsyncTLS != nil && usesSecret(rs, rs.Spec.RsyncTLS.KeySecret) {
+			users = append(users, rs)
+		}
+	}
+
+	for i := range rdList.Items {
+		if rd := &rdList.Items[i]; rd.Spec.RsyncTLS != nil && usesSecret(rd, rd.Spec.RsyncTLS.KeySecret) {
+			users = append(users, rd)
+		}
+	}
+
+	return users, nil
+}
+
+func (v *VSHandler) copySecretToPVCNamespace(secretName, pvcNamespace string) error {
 	secret := &corev1.Secret{}
 
 	err := v.client.Get(v.ctx,
 		types.NamespacedName{
 			Name:      secretName,
-			Namespace: pvcNamespacedName.Namespace,
+			Namespace: pvcNamespace,
 		}, secret)
 	if err != nil && !kerrors.IsNotFound(err) {
 		v.log.Error(err, "Failed to get secret", "secretName", secretName)
@@ -669,13 +1735,13 @@ func (v *VSHandler) copySecretToPVCNamespace(secretName string, pvcNamespacedNam
 
 	if err == nil {
 		v.log.Info("Secret already exists in the PVC namespace", "secretName", secretName, "pvcNamespace",
-			pvcNamespacedName.Namespace)
+			pvcNamespace)
 
 		return nil
 	}
 
 	v.log.Info("volsync secret not found in the pvc namespace, will create it", "secretName", secretName,
-		"pvcNamespace", pvcNamespacedName.Namespace)
+		"pvcNamespace", pvcNamespace)
 
 	err = v.client.Get(v.ctx,
 		types.NamespacedName{
@@ -690,7 +1756,7 @@ func (v *VSHandler) copySecretToPVCNamespace(secretName string, pvcNamespacedNam
 
 	secretCopy.ObjectMeta = metav1.ObjectMeta{
 		Name:        secretName,
-		Namespace:   pvcNamespacedName.Namespace,
+		Namespace:   pvcNamespace,
 		Labels:      secret.Labels,
 		Annotations: secret.Annotations,
 	}
@@ -771,6 +1837,10 @@ func (v *VSHandler) DeleteRD(pvcName string, pvcNamespace string) error {
 	return nil
 }
 
+// DeleteSnapshots deletes the VolumeSnapshots owned by the owner in the namespace, except those whose retention has
+// not expired. A retained snapshot is moved off the owner reference, so that it is not garbage collected with the
+// owner, and is deleted by a later call once its retention expires. Returns ErrSnapshotRetained while any snapshot
+// is retained, the earliest expiry of which is returned by SnapshotsRetainedUntil.
 func (v *VSHandler) DeleteSnapshots(pvcNamespace string) error {
 	// Remove a Snapshot by name that is owned (by parent vrg owner)
 	snapList := &snapv1.VolumeSnapshotList{}
@@ -780,9 +1850,25 @@ func (v *VSHandler) DeleteSnapshots(pvcNamespace string) error {
 		return err
 	}
 
+	retained := []string{}
+
 	for i := range snapList.Items {
 		snapshot := snapList.Items[i]
 
+		if retainUntil, ok := snapshotRetainedUntil(&snapshot); ok {
+			if err := v.retainSnapshot(&snapshot, retainUntil); err != nil {
+				return err
+			}
+
+			retained = append(retained, snapshot.GetNamespace()+"/"+snapshot.GetName())
+
+			continue
+		}
+
+		if err := v.ensureSnapshotContentDeletedWithSnapshot(&snapshot); err != nil {
+			return err
+		}
+
 		if err := v.client.Delete(v.ctx, &snapshot); err != nil {
 			if !kerrors.IsNotFound(err) {
 				v.log.Error(err, "Error cleaning up VolumeSnapshot", "name", snapshot.GetName())
@@ -794,6 +1880,89 @@ func (v *VSHandler) DeleteSnapshots(pvcNamespace string) error {
 		v.log.Info("Deleted VolumeSnapshot", "name", snapshot.GetName())
 	}
 
+	if len(retained) > 0 {
+		return fmt.Errorf("%w, snapshots: %v", ErrSnapshotRetained, retained)
+	}
+
+	return nil
+}
+
+// SnapshotsRetainedUntil returns the earliest expiry of the retention of the snapshots retained by DeleteSnapshots,
+// or the zero time if none is retained, or their retention cannot be parsed
+func (v *VSHandler) SnapshotsRetainedUntil() time.Time {
+	return v.snapshotsRetainedUntil
+}
+
+// retainSnapshot removes the owner reference of the owner from the snapshot while its retention has not expired,
+// keeping its owner labels, so that it outlives the owner until it is deleted by DeleteSnapshots
+func (v *VSHandler) retainSnapshot(snapshot *snapv1.VolumeSnapshot, retainUntil time.Time) error {
+	v.log.Info("Not deleting VolumeSnapshot before its retention expires", "name", snapshot.GetName(),
+		"namespace", snapshot.GetNamespace(), "retainUntil", snapshot.GetAnnotations()[RetainUntilAnnotation])
+
+	if !retainUntil.IsZero() && (v.snapshotsRetainedUntil.IsZero() || retainUntil.Before(v.snapshotsRetainedUntil)) {
+		v.snapshotsRetainedUntil = retainUntil
+	}
+
+	ownerRefs := []metav1.OwnerReference{}
+
+	for _, ownerRef := range snapshot.GetOwnerReferences() {
+		if ownerRef.UID != v.owner.GetUID() {
+			ownerRefs = append(ownerRefs, ownerRef)
+		}
+	}
+
+	if len(ownerRefs) == len(snapshot.GetOwnerReferences()) {
+		return nil
+	}
+
+	snapshot.SetOwnerReferences(ownerRefs)
+
+	if err := v.client.Update(v.ctx, snapshot); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error removing owner reference from retained VolumeSnapshot %s (%w)", snapshot.GetName(),
+			err)
+	}
+
+	return nil
+}
+
+// ensureSnapshotContentDeletedWithSnapshot updates the deletionPolicy of the VolumeSnapshotContent bound to the
+// snapshot from Retain to Delete, if enabled, so that deleting the snapshot does not leave the content (and the
+// storage backing it) behind.
+func (v *VSHandler) ensureSnapshotContentDeletedWithSnapshot(snapshot *snapv1.VolumeSnapshot) error {
+	if !v.volSyncConfig.RetainedSnapshotContentCleanup {
+		return nil
+	}
+
+	if snapshot.Status == nil || snapshot.Status.BoundVolumeSnapshotContentName == nil {
+		return nil
+	}
+
+	content := &snapv1.VolumeSnapshotContent{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: *snapshot.Status.BoundVolumeSnapshotContentName}, content)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get VolumeSnapshotContent of VolumeSnapshot %s (%w)", snapshot.GetName(), err)
+	}
+
+	// The snapshot of an imported content belongs to the peer cluster
+	if content.Spec.DeletionPolicy != snapv1.VolumeSnapshotContentRetain ||
+		strings.HasPrefix(content.GetName(), importSnapshotContentNamePrefix) {
+		return nil
+	}
+
+	content.Spec.DeletionPolicy = snapv1.VolumeSnapshotContentDelete
+
+	if err := v.client.Update(v.ctx, content); err != nil {
+		return fmt.Errorf("failed to update deletionPolicy of VolumeSnapshotContent %s (%w)", content.GetName(), err)
+	}
+
+	v.log.Info("Updated VolumeSnapshotContent deletionPolicy to Delete", "name", content.GetName(),
+		"volumeSnapshot", snapshot.GetName())
+
 	return nil
 }
 
@@ -884,6 +2053,187 @@ func (v *VSHandler) CleanupRDNotInSpecList(rdSpecList []ramendrv1alpha1.VolSyncR
 	return nil
 }
 
+// TeardownVolSync removes every VolSync artifact owned by the VRG, to downgrade it to no protection. All owned
+// ReplicationSources and ReplicationDestinations are deleted, including the local pair and read-only PVC of the
+// Direct copy method, which in turn garbage collects the services and ServiceExports of the destinations. Owned
+// VolumeSnapshots are released from the VolSync do-not-delete label and deleted. The protected PVCs are left intact,
+// and only released from the VRG. An error wrapping ErrVolSyncTeardownIncomplete is returned until no owned
+// artifacts remain, so the caller should call it again until it succeeds.
+//
+//nolint:cyclop,funlen
+func (v *VSHandler) TeardownVolSync() error {
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+
+	rdList, err := v.listRDByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+
+	localNames := map[types.NamespacedName]bool{}
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+		localNames[types.NamespacedName{Name: getLocalReplicationName(rd.GetName()), Namespace: rd.GetNamespace()}] = true
+	}
+
+	protectedPVCs := map[types.NamespacedName]bool{}
+
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+
+		if localNames[client.ObjectKeyFromObject(rs)] {
+			// The source of the local RS is the read-only PVC created from the snapshot of the main RD
+			if err := util.DeletePVC(v.ctx, v.client, rs.Spec.SourcePVC, rs.GetNamespace(), v.log); err != nil {
+				return err
+			}
+		} else {
+			protectedPVCs[types.NamespacedName{Name: rs.Spec.SourcePVC, Namespace: rs.GetNamespace()}] = true
+		}
+
+		if err := v.client.Delete(v.ctx, rs); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting ReplicationSource %s (%w)", rs.GetName(), err)
+		}
+
+		v.log.Info("Deleted ReplicationSource", "name", client.ObjectKeyFromObject(rs).String())
+	}
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+
+		if !localNames[client.ObjectKeyFromObject(rd)] {
+			// The protected PVC has the name of its RD
+			protectedPVCs[client.ObjectKeyFromObject(rd)] = true
+		}
+
+		if err := v.client.Delete(v.ctx, rd); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting ReplicationDestination %s (%w)", rd.GetName(), err)
+		}
+
+		v.log.Info("Deleted ReplicationDestination", "name", client.ObjectKeyFromObject(rd).String())
+	}
+
+	if err := v.releaseAndDeleteSnapshots(); err != nil {
+		return err
+	}
+
+	for pvcNamespacedName := range protectedPVCs {
+		if err := v.releasePVC(pvcNamespacedName); err != nil {
+			return err
+		}
+	}
+
+	return v.confirmVolSyncTeardown()
+}
+
+func (v *VSHandler) releaseAndDeleteSnapshots() error {
+	snapList := &snapv1.VolumeSnapshotList{}
+	if err := v.listByOwner(snapList, metav1.NamespaceAll); err != nil {
+		return err
+	}
+
+	for i := range snapList.Items {
+		snapshot := &snapList.Items[i]
+
+		// Retained snapshots are kept by DeleteSnapshots, protected from VolSync cleanup too
+		if _, retained := snapshotRetainedUntil(snapshot); retained {
+			continue
+		}
+
+		if util.HasLabelWithValue(snapshot, VolSyncDoNotDeleteLabel, VolSyncDoNotDeleteLabelVal) {
+			delete(snapshot.Labels, VolSyncDoNotDeleteLabel)
+
+			if err := v.client.Update(v.ctx, snapshot); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("error releasing VolumeSnapshot %s (%w)", snapshot.GetName(), err)
+			}
+		}
+	}
+
+	return v.DeleteSnapshots(metav1.NamespaceAll)
+}
+
+// snapshotRetainedUntil returns the time the retention of the snapshot expires, and true if it has not yet expired.
+// A snapshot whose retention cannot be parsed is considered retained, as it cannot be released safely, with a zero
+// expiry.
+func snapshotRetainedUntil(snapshot *snapv1.VolumeSnapshot) (time.Time, bool) {
+	value, ok := snapshot.GetAnnotations()[RetainUntilAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	retainUntil, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, true
+	}
+
+	return retainUntil, time.Now().Before(retainUntil)
+}
+
+// releasePVC removes the VRG owner reference and the ACM do-not-delete annotation from the PVC, leaving the PVC to
+// the user
+func (v *VSHandler) releasePVC(pvcNamespacedName types.NamespacedName) error {
+	pvc, err := v.getPVC(pvcNamespacedName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	ownerRefs := []metav1.OwnerReference{}
+
+	for _, ownerRef := range pvc.GetOwnerReferences() {
+		if ownerRef.UID != v.owner.GetUID() {
+			ownerRefs = append(ownerRefs, ownerRef)
+		}
+	}
+
+	_, annotated := pvc.GetAnnotations()[ACMAppSubDoNotDeleteAnnotation]
+	if len(ownerRefs) == len(pvc.GetOwnerReferences()) && !annotated {
+		return nil
+	}
+
+	pvc.SetOwnerReferences(ownerRefs)
+	delete(pvc.Annotations, ACMAppSubDoNotDeleteAnnotation)
+
+	if err := v.client.Update(v.ctx, pvc); err != nil {
+		return fmt.Errorf("error releasing PVC %s (%w)", pvcNamespacedName.String(), err)
+	}
+
+	v.log.Info("Released PVC", "pvc", pvcNamespacedName.String())
+
+	return nil
+}
+
+func (v *VSHandler) confirmVolSyncTeardown() error {
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+
+	rdList, err := v.listRDByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+
+	snapList := &snapv1.VolumeSnapshotList{}
+	if err := v.listByOwner(snapList, metav1.NamespaceAll); err != nil {
+		return err
+	}
+
+	if len(rsList.Items) != 0 || len(rdList.Items) != 0 || len(snapList.Items) != 0 {
+		return fmt.Errorf("%w, remaining ReplicationSources: %d, ReplicationDestinations: %d, VolumeSnapshots: %d",
+			ErrVolSyncTeardownIncomplete, len(rsList.Items), len(rdList.Items), len(snapList.Items))
+	}
+
+	v.log.Info("VolSync teardown complete")
+
+	return nil
+}
+
 // Make sure a ServiceExport exists to export the service for this RD to remote clusters
 // See: https://access.redhat.com/documentation/en-us/red_hat_advanced_cluster_management_for_kubernetes/
 // 2.4/html/services/services-overview#enable-service-discovery-submariner
@@ -903,6 +2253,10 @@ func (v *VSHandler) reconcileServiceExportForRD(rd *volsyncv1alpha1.ReplicationD
 	})
 
 	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, svcExport, func() error {
+		// Label the ServiceExport with its VRG, so that the VRG is reconciled to recreate it if deleted
+		util.AddLabel(svcExport, VRGOwnerNameLabel, v.owner.GetName())
+		util.AddLabel(svcExport, VRGOwnerNamespaceLabel, v.owner.GetNamespace())
+
 		// Make this ServiceExport owned by the replication destination itself rather than the VRG
 		// This way on relocate scenarios or failover/failback, when the RD is cleaned up the associated
 		// ServiceExport will get cleaned up with it.
@@ -951,6 +2305,45 @@ func (v *VSHandler) listRDByOwner(rdNamespace string) (volsyncv1alpha1.Replicati
 	return rdList, nil
 }
 
+// ListPVCsWithRSAndRD returns the PVCs for which both an owned ReplicationSource and an owned
+// ReplicationDestination exist. A PVC is only ever expected to have one of them, having both leads to
+// replication loops. The local RS and RD pair of the Direct copy method is expected, and not reported.
+func (v *VSHandler) ListPVCsWithRSAndRD() ([]types.NamespacedName, error) {
+	rsList, err := v.listRSByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	rdList, err := v.listRDByOwner(metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	rdNames := map[types.NamespacedName]bool{}
+	localNames := map[types.NamespacedName]bool{}
+
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+
+		rdNames[client.ObjectKeyFromObject(rd)] = true
+		localNames[types.NamespacedName{Name: getLocalReplicationName(rd.GetName()), Namespace: rd.GetNamespace()}] = true
+	}
+
+	pvcs := []types.NamespacedName{}
+
+	for i := range rsList.Items {
+		rsName := client.ObjectKeyFromObject(&rsList.Items[i])
+
+		if rdNames[rsName] && !localNames[rsName] {
+			v.log.Info("Found both a ReplicationSource and a ReplicationDestination for PVC", "pvc", rsName.String())
+
+			pvcs = append(pvcs, rsName)
+		}
+	}
+
+	return pvcs, nil
+}
+
 // Lists only RS/RD with VRGOwnerNameLabel that matches the owner
 func (v *VSHandler) listByOwner(list client.ObjectList, objNamespace string) error {
 	matchLabels := map[string]string{
@@ -971,8 +2364,65 @@ func (v *VSHandler) listByOwner(list client.ObjectList, objNamespace string) err
 	return nil
 }
 
+// RecreateMissingRestoredPVC restores the PVC of the RDSpec again from the latestImage of its ReplicationDestination,
+// if the PVC was deleted externally once restored, while the ReplicationDestination is still healthy. Returns true if
+// the PVC was recreated. A PVC that is replicated by a ReplicationSource, or that the ReplicationDestination
+// replicates to directly, is not recreated.
+func (v *VSHandler) RecreateMissingRestoredPVC(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+) (bool, error) {
+	if v.IsCopyMethodDirect() {
+		return false, nil
+	}
+
+	pvcName := rdSpec.ProtectedPVC.Name
+	pvcNamespace := rdSpec.ProtectedPVC.Namespace
+
+	_, err := v.getPVC(types.NamespacedName{Name: pvcName, Namespace: pvcNamespace})
+	if err == nil || !kerrors.IsNotFound(err) {
+		return false, err
+	}
+
+	if _, err := v.getRS(getReplicationSourceName(pvcName), pvcNamespace); err == nil || !kerrors.IsNotFound(err) {
+		return false, err
+	}
+
+	latestImage, err := v.getRDLatestImage(pvcName, pvcNamespace)
+	if err != nil || !isLatestImageReady(latestImage) {
+		return false, err
+	}
+
+	v.log.Info("Restored PVC is missing, recreating it from the ReplicationDestination latestImage",
+		"pvcName", pvcName, "pvcNamespace", pvcNamespace, "latestImage", latestImage.Name)
+
+	return true, v.EnsurePVCfromRD(rdSpec, false)
+}
+
 func (v *VSHandler) EnsurePVCfromRD(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec, failoverAction bool,
 ) error {
+	v.resolveStorageClassAlias(&rdSpec.ProtectedPVC)
+
+	if err := v.validatePVCSize(rdSpec.ProtectedPVC); err != nil {
+		return err
+	}
+
+	if err := v.ValidateAccessModes(rdSpec.ProtectedPVC); err != nil {
+		return err
+	}
+
+	if err := v.validatePVCNotTerminating(rdSpec.ProtectedPVC); err != nil {
+		return err
+	}
+
+	// On a storage backend shared with the peer cluster, restore from the snapshot of the peer cluster
+	if v.volSyncConfig.SnapshotImportEnabled && rdSpec.ProtectedPVC.SnapshotImport != nil && !v.IsCopyMethodDirect() {
+		snapshotRef, err := v.importSnapshot(rdSpec.ProtectedPVC)
+		if err != nil {
+			return err
+		}
+
+		return v.validateSnapshotAndEnsurePVC(rdSpec, *snapshotRef, failoverAction)
+	}
+
 	latestImage, err := v.getRDLatestImage(rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 	if err != nil {
 		return err
@@ -1039,6 +2489,14 @@ func (v *VSHandler) EnsurePVCforDirectCopy(ctx context.Context,
 			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
 			volumeMode := corev1.PersistentVolumeFilesystem
 			pvc.Spec.VolumeMode = &volumeMode
+
+			// Protected annotations, e.g. an encryption key reference, are needed to provision the volume. OCM
+			// annotations are left out, as this PVC is not owned by OCM.
+			for key, val := range rdSpec.ProtectedPVC.Annotations {
+				if !strings.HasPrefix(key, "apps.open-cluster-management.io") {
+					util.AddAnnotation(pvc, key, val)
+				}
+			}
 		}
 
 		pvc.Spec.Resources.Requests = rdSpec.ProtectedPVC.Resources.Requests
@@ -1071,6 +2529,13 @@ func (v *VSHandler) validateSnapshotAndEnsurePVC(rdSpec ramendrv1alpha1.VolSyncR
 		return err
 	}
 
+	// A PVC with a datasource of a snapshot that is not ready to use stays Pending, requeue instead
+	if snap.Status == nil || snap.Status.ReadyToUse == nil || !*snap.Status.ReadyToUse {
+		v.log.Info("VolumeSnapshot is not ready to use yet", "snapshotName", snap.GetName())
+
+		return fmt.Errorf("%w: %s/%s", ErrSnapshotNotReady, snap.GetNamespace(), snap.GetName())
+	}
+
 	if v.IsCopyMethodDirect() {
 		// Directly use the RD pvc
 		v.log.V(1).Info(fmt.Sprintf("Using copyMethod '%s'. latestImage %s. pvcName %s",
@@ -1121,7 +2586,7 @@ func (v *VSHandler) validateSnapshotAndEnsurePVC(rdSpec ramendrv1alpha1.VolSyncR
 		return err
 	}
 
-	return nil
+	return v.validateRequiredRestoredPVCLabels(pvc)
 }
 
 func (v *VSHandler) rollbackToLastSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
@@ -1180,6 +2645,24 @@ func (v *VSHandler) rollbackToLastSnapshot(rdSpec ramendrv1alpha1.VolSyncReplica
 	return nil
 }
 
+// setPVCStorageRequest sets the storage request of the PVC to the capacity, unless that would shrink the request of
+// the existing PVC, which is disallowed, in which case its larger request is kept
+func setPVCStorageRequest(pvc *corev1.PersistentVolumeClaim, capacity resource.Quantity, log logr.Logger) {
+	if !pvc.CreationTimestamp.IsZero() {
+		current, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if ok && capacity.Cmp(current) < 0 {
+			log.Info("Ignoring a storage request smaller than that of the existing PVC, PVCs cannot shrink",
+				"current", current.String(), "requested", capacity.String())
+
+			return
+		}
+	}
+
+	pvc.Spec.Resources.Requests = corev1.ResourceList{
+		corev1.ResourceStorage: capacity,
+	}
+}
+
 //nolint:funlen,gocognit,cyclop
 func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
 	snapshotRef corev1.TypedLocalObjectReference, snapRestoreSize *resource.Quantity,
@@ -1194,23 +2677,42 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 		},
 	}
 
-	pvcRequestedCapacity := rdSpec.ProtectedPVC.Resources.Requests.Storage()
+	pvcRequestedCapacity, err := restoreCapacity(rdSpec.ProtectedPVC)
+	if err != nil {
+		l.Error(err, "Unable to determine the capacity of the restored PVC")
+
+		return nil, err
+	}
+
 	if snapRestoreSize != nil {
 		if pvcRequestedCapacity == nil || snapRestoreSize.Cmp(*pvcRequestedCapacity) > 0 {
 			pvcRequestedCapacity = snapRestoreSize
+		} else if pvcRequestedCapacity.Cmp(*snapRestoreSize) > 0 {
+			if err := v.validateRestoreToLargerSize(rdSpec.ProtectedPVC.StorageClassName); err != nil {
+				l.Error(err, "Unable to restore PVC with a size larger than the snapshot restore size")
+
+				return nil, err
+			}
 		}
 	}
 
+	if err := v.validateReadOnlyManyRestore(rdSpec.ProtectedPVC); err != nil {
+		l.Error(err, "Unable to restore PVC ReadOnlyMany")
+
+		return nil, err
+	}
+
 	pvcNeedsRecreation := false
 
 	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, pvc, func() error {
-		if !pvc.CreationTimestamp.IsZero() && !objectRefMatches(pvc.Spec.DataSource, &snapshotRef) {
+		if !pvc.CreationTimestamp.IsZero() && !pvcDataSourceMatches(pvc, &snapshotRef) {
 			// If this pvc already exists and not pointing to our desired snapshot, we will need to
 			// delete it and re-create as we cannot update the datasource
 			pvcNeedsRecreation = true
 
 			return nil
 		}
+		v.releaseQuarantinedPVC(pvc)
 		if pvc.Status.Phase == corev1.ClaimBound {
 			// PVC already bound at this point
 			l.V(1).Info("PVC already bound")
@@ -1219,6 +2721,7 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 		}
 
 		util.UpdateStringMap(&pvc.Labels, rdSpec.ProtectedPVC.Labels)
+		util.UpdateStringMap(&pvc.Labels, v.requiredRestoredPVCLabels)
 		util.UpdateStringMap(&pvc.Annotations, rdSpec.ProtectedPVC.Annotations)
 
 		accessModes := []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce} // Default value
@@ -1231,13 +2734,15 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
 
 			// Only set when initially creating
-			pvc.Spec.DataSource = &snapshotRef
-		}
+			v.setPVCDataSource(pvc, snapshotRef)
 
-		pvc.Spec.Resources.Requests = corev1.ResourceList{
-			corev1.ResourceStorage: *pvcRequestedCapacity,
+			if v.volSyncConfig.RestoredPVCMode == RestoredPVCModeQuarantine {
+				util.AddLabel(pvc, QuarantineLabel, QuarantineLabelVal)
+			}
 		}
 
+		setPVCStorageRequest(pvc, *pvcRequestedCapacity, l)
+
 		return nil
 	})
 	if err != nil {
@@ -1265,11 +2770,86 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 	return pvc, nil
 }
 
+// releaseQuarantinedPVC removes the quarantine label of a restored PVC once it is annotated as released, e.g. by the
+// scanner of its data
+func (v *VSHandler) releaseQuarantinedPVC(pvc *corev1.PersistentVolumeClaim) {
+	if !util.HasLabelWithValue(pvc, QuarantineLabel, QuarantineLabelVal) ||
+		pvc.GetAnnotations()[QuarantineReleasedAnnotation] != QuarantineReleasedAnnotationVal {
+		return
+	}
+
+	delete(pvc.Labels, QuarantineLabel)
+
+	v.log.Info("Released quarantined PVC", "pvcName", pvc.GetName())
+}
+
+// restoreCapacity returns the capacity requested by the protected PVC, or the capacity of its
+// RestoreCapacityAnnotation if any, which may not be less than the capacity requested by the protected PVC
+func restoreCapacity(protectedPVC ramendrv1alpha1.ProtectedPVC) (*resource.Quantity, error) {
+	requestedCapacity := protectedPVC.Resources.Requests.Storage()
+
+	value, ok := protectedPVC.Annotations[RestoreCapacityAnnotation]
+	if !ok {
+		return requestedCapacity, nil
+	}
+
+	capacity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w, pvc: %s, annotation %s: %s (%v)", ErrInvalidRestoreCapacity,
+			protectedPVC.Name, RestoreCapacityAnnotation, value, err)
+	}
+
+	if capacity.Cmp(*requestedCapacity) < 0 {
+		return nil, fmt.Errorf("%w, pvc: %s, restore capacity %s is less than requested capacity %s",
+			ErrInvalidRestoreCapacity, protectedPVC.Name, capacity.String(), requestedCapacity.String())
+	}
+
+	return &capacity, nil
+}
+
+// validateRestoreToLargerSize checks that the storage class allows volume expansion, which some drivers require to
+// restore a PVC with a size larger than the restore size of its snapshot
+func (v *VSHandler) validateRestoreToLargerSize(storageClassName *string) error {
+	storageClass, err := v.getStorageClass(storageClassName)
+	if err != nil {
+		return err
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return fmt.Errorf("%w, storage class: %s", ErrVolumeExpansionNotSupported, storageClass.GetName())
+	}
+
+	return nil
+}
+
 // validateAndProtectSnapshot Validates snapshot exists, adds the vrg as the owner, and
 // adds VolSync "do-not-delete" label to indicate volsync should not cleanup this snapshot
 func (v *VSHandler) validateAndProtectSnapshot(
 	volumeSnapshotRef corev1.TypedLocalObjectReference,
 	volumeSnapshotNamespace string,
+) (*snapv1.VolumeSnapshot, error) {
+	var volSnap *snapv1.VolumeSnapshot
+
+	// VolSync may update the snapshot concurrently, so retry the label addition on conflicts rather than failing the
+	// restore
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
+		volSnap, err = v.protectSnapshot(volumeSnapshotRef, volumeSnapshotNamespace)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v.log.V(1).Info("VolumeSnapshot validated and protected", "volumesnapshot name", volSnap.GetName())
+
+	return volSnap, nil
+}
+
+// protectSnapshot gets the snapshot and adds the owner and labels protecting it from VolSync cleanup
+func (v *VSHandler) protectSnapshot(
+	volumeSnapshotRef corev1.TypedLocalObjectReference,
+	volumeSnapshotNamespace string,
 ) (*snapv1.VolumeSnapshot, error) {
 	volSnap := &snapv1.VolumeSnapshot{}
 
@@ -1292,6 +2872,13 @@ func (v *VSHandler) validateAndProtectSnapshot(
 		updater.AddOwner(v.owner, v.client.Scheme())
 	}
 
+	// Record the retention of the snapshot once, when it is first protected
+	_, retentionRecorded := volSnap.GetAnnotations()[RetainUntilAnnotation]
+	if v.volSyncConfig.SnapshotRetentionSeconds > 0 && !retentionRecorded {
+		retention := time.Duration(v.volSyncConfig.SnapshotRetentionSeconds) * time.Second
+		updater.AddAnnotation(RetainUntilAnnotation, time.Now().Add(retention).UTC().Format(time.RFC3339))
+	}
+
 	err = updater.AddLabel(VRGOwnerNameLabel, v.owner.GetName()).
 		AddLabel(VRGOwnerNamespaceLabel, v.owner.GetNamespace()).
 		AddLabel(VolSyncDoNotDeleteLabel, VolSyncDoNotDeleteLabelVal).
@@ -1300,8 +2887,6 @@ func (v *VSHandler) validateAndProtectSnapshot(
 		return nil, fmt.Errorf("failed to add owner/label to snapshot %s (%w)", volSnap.GetName(), err)
 	}
 
-	v.log.V(1).Info("VolumeSnapshot validated and protected", "volumesnapshot name", volSnap.GetName())
-
 	return volSnap, nil
 }
 
@@ -1433,24 +3018,17 @@ func (v *VSHandler) GetVolumeSnapshotClassFromPVCStorageClass(storageClassName *
 }
 
 func (v *VSHandler) getVolumeSnapshotClassFromPVCStorageClass(storageClass *storagev1.StorageClass) (string, error) {
-	volumeSnapshotClasses, err := v.GetVolumeSnapshotClasses()
-	if err != nil {
-		return "", err
+	if volumeSnapshotClassName, ok := storageClass.GetAnnotations()[VolumeSnapshotClassAnnotation]; ok {
+		return v.validateVolumeSnapshotClassOverride(storageClass, volumeSnapshotClassName)
 	}
 
-	var matchedVolumeSnapshotClassName string
-
-	for _, volumeSnapshotClass := range volumeSnapshotClasses {
-		if volumeSnapshotClass.Driver == storageClass.Provisioner {
-			// Match the first one where driver/provisioner == the storage class provisioner
-			// But keep looping - if we find the default storageVolumeClass, use it instead
-			if matchedVolumeSnapshotClassName == "" || isDefaultVolumeSnapshotClass(volumeSnapshotClass) {
-				matchedVolumeSnapshotClassName = volumeSnapshotClass.GetName()
-			}
-		}
+	volumeSnapshotClassByDriver, err := v.getVolumeSnapshotClassByDriver()
+	if err != nil {
+		return "", err
 	}
 
-	if matchedVolumeSnapshotClassName == "" {
+	matchedVolumeSnapshotClass, ok := volumeSnapshotClassByDriver[storageClass.Provisioner]
+	if !ok {
 		noVSCFoundErr := fmt.Errorf("unable to find matching volumesnapshotclass for storage provisioner %s",
 			storageClass.Provisioner)
 		v.log.Error(noVSCFoundErr, "No VolumeSnapshotClass found")
@@ -1458,7 +3036,145 @@ func (v *VSHandler) getVolumeSnapshotClassFromPVCStorageClass(storageClass *stor
 		return "", noVSCFoundErr
 	}
 
-	return matchedVolumeSnapshotClassName, nil
+	return matchedVolumeSnapshotClass.GetName(), nil
+}
+
+// validateVolumeSnapshotClassOverride cross-checks the driver of the VolumeSnapshotClass a storage class is
+// annotated to use against the storage class provisioner. Returns an error wrapping
+// ErrVolumeSnapshotClassDriverMismatch, naming both, if they differ.
+func (v *VSHandler) validateVolumeSnapshotClassOverride(storageClass *storagev1.StorageClass,
+	volumeSnapshotClassName string,
+) (string, error) {
+	volumeSnapshotClass := &snapv1.VolumeSnapshotClass{}
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: volumeSnapshotClassName}, volumeSnapshotClass); err != nil {
+		return "", fmt.Errorf("error getting volumesnapshotclass %s set on storageclass %s (%w)",
+			volumeSnapshotClassName, storageClass.GetName(), err)
+	}
+
+	if volumeSnapshotClass.Driver != storageClass.Provisioner {
+		mismatchErr := fmt.Errorf("%w: volumesnapshotclass %s has driver %s, storageclass %s has provisioner %s",
+			ErrVolumeSnapshotClassDriverMismatch, volumeSnapshotClass.GetName(), volumeSnapshotClass.Driver,
+			storageClass.GetName(), storageClass.Provisioner)
+		v.log.Error(mismatchErr, "Invalid VolumeSnapshotClass override")
+
+		return "", mismatchErr
+	}
+
+	return volumeSnapshotClass.GetName(), nil
+}
+
+// getVolumeSnapshotClassByDriver returns the volume snapshot class to use for each driver/provisioner, computing it
+// once from the list of volume snapshot classes so that lookups for subsequent PVCs do not rescan the list
+func (v *VSHandler) getVolumeSnapshotClassByDriver() (map[string]*snapv1.VolumeSnapshotClass, error) {
+	if v.volumeSnapshotClassByDriver != nil {
+		return v.volumeSnapshotClassByDriver, nil
+	}
+
+	volumeSnapshotClasses, err := v.GetVolumeSnapshotClasses()
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSnapshotClassByDriver := map[string]*snapv1.VolumeSnapshotClass{}
+
+	for i := range volumeSnapshotClasses {
+		volumeSnapshotClass := &volumeSnapshotClasses[i]
+
+		// Match the first one where driver/provisioner == the storage class provisioner
+		// But keep looping - if we find the default storageVolumeClass, use it instead
+		if _, ok := volumeSnapshotClassByDriver[volumeSnapshotClass.Driver]; !ok ||
+			isDefaultVolumeSnapshotClass(*volumeSnapshotClass) {
+			volumeSnapshotClassByDriver[volumeSnapshotClass.Driver] = volumeSnapshotClass
+		}
+	}
+
+	for _, volumeSnapshotClass := range volumeSnapshotClassByDriver {
+		if volumeSnapshotClass.DeletionPolicy == snapv1.VolumeSnapshotContentRetain &&
+			!v.volSyncConfig.RetainedSnapshotContentCleanup {
+			v.log.Info("Warning: VolumeSnapshotClass has deletionPolicy Retain, VolumeSnapshotContents will be left"+
+				" behind when VolumeSnapshots are deleted", "volumeSnapshotClass", volumeSnapshotClass.GetName())
+		}
+	}
+
+	v.volumeSnapshotClassByDriver = volumeSnapshotClassByDriver
+
+	return v.volumeSnapshotClassByDriver, nil
+}
+
+// ValidateAccessModes checks the access modes requested by the protected PVC against the access modes its storage
+// class is annotated to support. Storage classes without the annotation are assumed to support any access mode.
+// Returns an error wrapping ErrAccessModeNotSupported if any requested access mode is not supported.
+func (v *VSHandler) ValidateAccessModes(protectedPVC ramendrv1alpha1.ProtectedPVC) error {
+	if len(protectedPVC.AccessModes) == 0 ||
+		protectedPVC.StorageClassName == nil || *protectedPVC.StorageClassName == "" {
+		return nil
+	}
+
+	storageClass, err := v.getStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	supportedAccessModes, ok := storageClass.GetAnnotations()[SupportedAccessModesAnnotation]
+	if !ok {
+		return nil
+	}
+
+	supported := map[corev1.PersistentVolumeAccessMode]struct{}{}
+	for _, accessMode := range strings.Split(supportedAccessModes, ",") {
+		supported[corev1.PersistentVolumeAccessMode(strings.TrimSpace(accessMode))] = struct{}{}
+	}
+
+	unsupported := []corev1.PersistentVolumeAccessMode{}
+
+	for _, accessMode := range protectedPVC.AccessModes {
+		if _, ok := supported[accessMode]; !ok {
+			unsupported = append(unsupported, accessMode)
+		}
+	}
+
+	if len(unsupported) != 0 {
+		return fmt.Errorf("%w: pvc %s requests %v, storage class %s supports %s", ErrAccessModeNotSupported,
+			protectedPVC.Name, unsupported, storageClass.GetName(), supportedAccessModes)
+	}
+
+	return nil
+}
+
+// validateReadOnlyManyRestore checks that a protected PVC requesting the ReadOnlyMany access mode is restored from a
+// snapshot of a driver and VolumeSnapshotClass that support it. CephFS does, other drivers are required to have their
+// VolumeSnapshotClass annotated to. Returns an error wrapping ErrAccessModeNotSupported, naming both, otherwise.
+func (v *VSHandler) validateReadOnlyManyRestore(protectedPVC ramendrv1alpha1.ProtectedPVC) error {
+	if !slices.Contains(protectedPVC.AccessModes, corev1.ReadOnlyMany) {
+		return nil
+	}
+
+	storageClass, err := v.getStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	if storageClass.Provisioner == v.defaultCephFSCSIDriverName {
+		return nil
+	}
+
+	volumeSnapshotClassName, err := v.getVolumeSnapshotClassFromPVCStorageClass(storageClass)
+	if err != nil {
+		return err
+	}
+
+	volumeSnapshotClass := &snapv1.VolumeSnapshotClass{}
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: volumeSnapshotClassName}, volumeSnapshotClass); err != nil {
+		return fmt.Errorf("error getting volumesnapshotclass %s (%w)", volumeSnapshotClassName, err)
+	}
+
+	if volumeSnapshotClass.GetAnnotations()[ReadOnlyManyRestoreAnnotation] != ReadOnlyManyRestoreAnnotationVal {
+		return fmt.Errorf("%w: pvc %s requests %s, driver %s with volumesnapshotclass %s does not support "+
+			"ReadOnlyMany restores", ErrAccessModeNotSupported, protectedPVC.Name, corev1.ReadOnlyMany,
+			storageClass.Provisioner, volumeSnapshotClassName)
+	}
+
+	return nil
 }
 
 func (v *VSHandler) getStorageClass(storageClassName *string) (*storagev1.StorageClass, error) {
@@ -1469,6 +3185,8 @@ func (v *VSHandler) getStorageClass(storageClassName *string) (*storagev1.Storag
 		return nil, err
 	}
 
+	storageClassName = v.currentStorageClassName(storageClassName)
+
 	storageClass := &storagev1.StorageClass{}
 	if err := v.client.Get(v.ctx, types.NamespacedName{Name: *storageClassName}, storageClass); err != nil {
 		v.log.Error(err, "Failed to get StorageClass", "name", storageClassName)
@@ -1517,8 +3235,12 @@ func (v *VSHandler) GetVolumeSnapshotClasses() ([]snapv1.VolumeSnapshotClass, er
 	return v.volumeSnapshotClassList.Items, nil
 }
 
-func (v *VSHandler) getScheduleCronSpec() (*string, error) {
+func (v *VSHandler) getScheduleCronSpec(pvcName string) (*string, error) {
 	if v.schedulingInterval != "" {
+		if v.scheduleJitter {
+			return ConvertSchedulingIntervalToJitteredCronSpec(v.schedulingInterval, pvcName)
+		}
+
 		return ConvertSchedulingIntervalToCronSpec(v.schedulingInterval)
 	}
 
@@ -1573,6 +3295,46 @@ func ConvertSchedulingIntervalToCronSpec(schedulingInterval string) (*string, er
 	return &cronSpec, nil
 }
 
+// ConvertSchedulingIntervalToJitteredCronSpec converts the scheduling interval to a cronspec with the same period as
+// ConvertSchedulingIntervalToCronSpec, but starting at an offset within the interval derived from a hash of key, e.g.
+// a PVC name. The offset is stable for a key, while the schedules of different keys are spread over the interval.
+func ConvertSchedulingIntervalToJitteredCronSpec(schedulingInterval, key string) (*string, error) {
+	if _, err := ConvertSchedulingIntervalToCronSpec(schedulingInterval); err != nil {
+		return nil, err
+	}
+
+	mhd := strings.ToLower(schedulingInterval[len(schedulingInterval)-1:])
+
+	num, err := strconv.Atoi(schedulingInterval[:len(schedulingInterval)-1])
+	if err != nil {
+		return nil, fmt.Errorf("scheduling interval prefix %s cannot be convered to an int value",
+			schedulingInterval[:len(schedulingInterval)-1])
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+
+	offset := int(hash.Sum32())
+
+	var cronSpec string
+
+	switch mhd {
+	case "m":
+		// The minute field is 0-59, so longer intervals are offset within their first hour
+		cronSpec = fmt.Sprintf("%d-59/%d * * * *", offset%min(num, minutesPerHour), num)
+	case "h":
+		// The hour field is 0-23, so longer intervals are offset within their first day
+		offset %= min(num, hoursPerDay) * minutesPerHour
+		cronSpec = fmt.Sprintf("%d %d-23/%d * * *", offset%minutesPerHour, offset/minutesPerHour, num)
+	case "d":
+		num = min(num, CronSpecMaxDayOfMonth)
+		offset %= hoursPerDay * minutesPerHour
+		cronSpec = fmt.Sprintf("%d %d */%d * *", offset%minutesPerHour, offset/minutesPerHour, num)
+	}
+
+	return &cronSpec, nil
+}
+
 func (v *VSHandler) IsRSDataProtected(pvcName, pvcNamespace string) (bool, error) {
 	l := v.log.WithValues("pvcName", pvcName)
 
@@ -1706,6 +3468,44 @@ func getRemoteServiceNameForRDFromPVCName(pvcName, rdNamespace string) string {
 	return fmt.Sprintf("%s.%s.svc.clusterset.local", getLocalServiceNameForRDFromPVCName(pvcName), rdNamespace)
 }
 
+// ReplicationNames are the canonical names of the VolSync resources that replicate a PVC
+type ReplicationNames struct {
+	// ReplicationSource is the name of the ReplicationSource of the PVC on the primary cluster
+	ReplicationSource string
+	// ReplicationDestination is the name of the ReplicationDestination of the PVC on the secondary cluster
+	ReplicationDestination string
+	// LocalReplication is the name of the local ReplicationSource and ReplicationDestination used to restore the PVC
+	// when the copy method is Direct
+	LocalReplication string
+	// LocalService is the name of the Service VolSync creates for the ReplicationDestination, in its namespace
+	LocalService string
+	// RemoteService is the address of the exported Service of the ReplicationDestination, as the peer cluster
+	// accesses it
+	RemoteService string
+}
+
+// GetReplicationNames returns the canonical names of the VolSync resources that replicate the PVC, so that external
+// tooling does not need to reimplement the naming
+func GetReplicationNames(pvcName, pvcNamespace string) ReplicationNames {
+	return ReplicationNames{
+		ReplicationSource:      getReplicationSourceName(pvcName),
+		ReplicationDestination: getReplicationDestinationName(pvcName),
+		LocalReplication:       getLocalReplicationName(pvcName),
+		LocalService:           getLocalServiceNameForRDFromPVCName(pvcName),
+		RemoteService:          getRemoteServiceNameForRDFromPVCName(pvcName, pvcNamespace),
+	}
+}
+
+// GetReplicationSourceAddress returns the address of the ReplicationDestination on the peer cluster that the
+// ReplicationSource is configured to replicate to, or "" if it has none
+func GetReplicationSourceAddress(rs *volsyncv1alpha1.ReplicationSource) string {
+	if rs.Spec.RsyncTLS == nil || rs.Spec.RsyncTLS.Address == nil {
+		return ""
+	}
+
+	return *rs.Spec.RsyncTLS.Address
+}
+
 func getKindAndName(scheme *runtime.Scheme, obj client.Object) string {
 	ref, err := reference.GetReference(scheme, obj)
 	if err != nil {
@@ -1727,6 +3527,34 @@ func objectRefMatches(a, b *corev1.TypedLocalObjectReference) bool {
 	return a.Kind == b.Kind && a.Name == b.Name
 }
 
+// setPVCDataSource sets the snapshot to restore the PVC from, in its dataSourceRef if supported, otherwise in its
+// dataSource. The API server populates dataSource from dataSourceRef for snapshots.
+func (v *VSHandler) setPVCDataSource(pvc *corev1.PersistentVolumeClaim, snapshotRef corev1.TypedLocalObjectReference) {
+	if !v.pvcDataSourceRefSupported {
+		pvc.Spec.DataSource = &snapshotRef
+
+		return
+	}
+
+	pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
+		APIGroup: snapshotRef.APIGroup,
+		Kind:     snapshotRef.Kind,
+		Name:     snapshotRef.Name,
+	}
+}
+
+func pvcDataSourceMatches(pvc *corev1.PersistentVolumeClaim, snapshotRef *corev1.TypedLocalObjectReference) bool {
+	if pvc.Spec.DataSourceRef != nil {
+		return pvc.Spec.DataSourceRef.Namespace == nil &&
+			objectRefMatches(&corev1.TypedLocalObjectReference{
+				Kind: pvc.Spec.DataSourceRef.Kind,
+				Name: pvc.Spec.DataSourceRef.Name,
+			}, snapshotRef)
+	}
+
+	return objectRefMatches(pvc.Spec.DataSource, snapshotRef)
+}
+
 // ValidateObjectExists indicates whether a kubernetes resource exists in APIServer
 func ValidateObjectExists(ctx context.Context, c client.Client, obj client.Object) error {
 	key := client.ObjectKeyFromObject(obj)
@@ -1784,6 +3612,11 @@ func (v *VSHandler) reconcileLocalRD(rdSpec ramendrv1alpha1.VolSyncReplicationDe
 		return nil, err
 	}
 
+	moverServiceAccount, err := v.getMoverServiceAccount(rdSpec.ProtectedPVC.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, lrd, func() error {
 		if !v.vrgInAdminNamespace {
 			if err := ctrl.SetControllerReference(v.owner, lrd, v.client.Scheme()); err != nil {
@@ -1803,8 +3636,9 @@ func (v *VSHandler) reconcileLocalRD(rdSpec ramendrv1alpha1.VolSyncReplicationDe
 		}
 
 		lrd.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
-			ServiceType: v.getRsyncServiceType(),
-			KeySecret:   &pskSecretName,
+			ServiceType:         v.getRsyncServiceType(),
+			KeySecret:           &pskSecretName,
+			MoverServiceAccount: moverServiceAccount,
 
 			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 				CopyMethod:       volsyncv1alpha1.CopyMethodDirect,
@@ -1820,6 +3654,9 @@ func (v *VSHandler) reconcileLocalRD(rdSpec ramendrv1alpha1.VolSyncReplicationDe
 	if err != nil {
 		return nil, err
 	}
+
+	v.reconcileSummary.record(op)
+
 	// Now check status - only return an RD if we have an address filled out in the ReplicationDestination Status
 	if lrd.Status == nil || lrd.Status.RsyncTLS == nil || lrd.Status.RsyncTLS.Address == nil {
 		v.log.V(1).Info("Local ReplicationDestination waiting for Address...")
@@ -1899,6 +3736,8 @@ func (v *VSHandler) reconcileLocalRS(rd *volsyncv1alpha1.ReplicationDestination,
 		return nil, err
 	}
 
+	v.reconcileSummary.record(op)
+
 	return lrs, nil
 }
 
@@ -2039,12 +3878,10 @@ func (v *VSHandler) createReadOnlyPVCFromSnapshot(rd *volsyncv1alpha1.Replicatio
 			pvc.Spec.StorageClassName = rd.Spec.RsyncTLS.StorageClassName
 
 			// Only set when initially creating
-			pvc.Spec.DataSource = &snapshotRef
+			v.setPVCDataSource(pvc, snapshotRef)
 		}
 
-		pvc.Spec.Resources.Requests = corev1.ResourceList{
-			corev1.ResourceStorage: *pvcRequestedCapacity,
-		}
+		setPVCStorageRequest(pvc, *pvcRequestedCapacity, l)
 
 		return nil
 	})
@@ -2131,6 +3968,11 @@ func (v *VSHandler) pauseRD(rdName, rdNamespace string) (*volsyncv1alpha1.Replic
 		return rd, nil
 	}
 
+	if !v.Capabilities().Pause {
+		// A restore from the latest image of an RD that is not paused could race a new sync
+		return nil, fmt.Errorf("pausing ReplicationDestination %s %w", rdName, ErrCapabilityNotSupported)
+	}
+
 	rd.Spec.Paused = true
 
 	return rd, v.updateResource(rd)
@@ -4,6 +4,7 @@
 package volsync_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -13,12 +14,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -74,6 +78,183 @@ var _ = Describe("VolSync Handler - utils", func() {
 			Expect(err).To((HaveOccurred()))
 		})
 	})
+
+	Context("When converting scheduling interval to a jittered cronspec for VolSync", func() {
+		It("Should offset an interval specified in minutes within the interval", func() {
+			cronSpecSchedule, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("10m", "pvc-a")
+			Expect(err).NotTo((HaveOccurred()))
+			Expect(cronSpecSchedule).ToNot(BeNil())
+			Expect(*cronSpecSchedule).To(MatchRegexp(`^[0-9]-59/10 \* \* \* \*$`))
+		})
+		It("Should offset an interval specified in hours within the interval", func() {
+			cronSpecSchedule, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("2h", "pvc-a")
+			Expect(err).NotTo((HaveOccurred()))
+			Expect(cronSpecSchedule).ToNot(BeNil())
+			Expect(*cronSpecSchedule).To(MatchRegexp(`^[0-9]+ [01]-23/2 \* \* \*$`))
+		})
+		It("Should offset an interval specified in days within the first day", func() {
+			cronSpecSchedule, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("13d", "pvc-a")
+			Expect(err).NotTo((HaveOccurred()))
+			Expect(cronSpecSchedule).ToNot(BeNil())
+			Expect(*cronSpecSchedule).To(MatchRegexp(`^[0-9]+ [0-9]+ \*/13 \* \*$`))
+		})
+		It("Should return the same cronspec for the same PVC", func() {
+			first, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("1h", "pvc-a")
+			Expect(err).NotTo((HaveOccurred()))
+			second, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("1h", "pvc-a")
+			Expect(err).NotTo((HaveOccurred()))
+			Expect(*second).To(Equal(*first))
+		})
+		It("Should spread the cronspecs of different PVCs", func() {
+			cronSpecSchedules := map[string]bool{}
+			for i := 0; i < 10; i++ {
+				cronSpecSchedule, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("1h", fmt.Sprintf("pvc-%d", i))
+				Expect(err).NotTo((HaveOccurred()))
+				cronSpecSchedules[*cronSpecSchedule] = true
+			}
+			Expect(len(cronSpecSchedules)).To(BeNumerically(">", 1))
+		})
+		It("Should fail if interval is invalid", func() {
+			_, err := volsync.ConvertSchedulingIntervalToJitteredCronSpec("123", "pvc-a")
+			Expect(err).To((HaveOccurred()))
+		})
+	})
+
+	Context("When checking PVC dataSourceRef support of a Kubernetes server version", func() {
+		It("Should not be supported before 1.24", func() {
+			Expect(volsync.PVCDataSourceRefSupported("v1.23.17")).To(BeFalse())
+		})
+		It("Should be supported from 1.24", func() {
+			Expect(volsync.PVCDataSourceRefSupported("v1.24.0")).To(BeTrue())
+			Expect(volsync.PVCDataSourceRefSupported("v1.28.3+k3s1")).To(BeTrue())
+		})
+		It("Should not be supported for an unparsable version", func() {
+			Expect(volsync.PVCDataSourceRefSupported("")).To(BeFalse())
+		})
+	})
+
+	Context("When getting the replication names of a PVC", func() {
+		It("Should return the canonical names", func() {
+			Expect(volsync.GetReplicationNames("pvc", "ns")).To(Equal(volsync.ReplicationNames{
+				ReplicationSource:      "pvc",
+				ReplicationDestination: "pvc",
+				LocalReplication:       "pvc-local",
+				LocalService:           "volsync-rsync-tls-dst-pvc",
+				RemoteService:          "volsync-rsync-tls-dst-pvc.ns.svc.clusterset.local",
+			}))
+		})
+	})
+
+	Context("When getting the address a ReplicationSource replicates to", func() {
+		It("Should be empty if the ReplicationSource has no rsync-tls address", func() {
+			rs := &volsyncv1alpha1.ReplicationSource{}
+			Expect(volsync.GetReplicationSourceAddress(rs)).To(BeEmpty())
+
+			rs.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{}
+			Expect(volsync.GetReplicationSourceAddress(rs)).To(BeEmpty())
+		})
+		It("Should be the rsync-tls address of the ReplicationSource", func() {
+			address := "volsync-rsync-tls-dst-pvc.ns.svc.clusterset.local"
+			rs := &volsyncv1alpha1.ReplicationSource{
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{Address: &address},
+				},
+			}
+			Expect(volsync.GetReplicationSourceAddress(rs)).To(Equal(address))
+		})
+	})
+})
+
+var _ = Describe("VolSync Handler - Validate access modes", func() {
+	var vsHandler *volsync.VSHandler
+	var storageClass *storagev1.StorageClass
+
+	protectedPVC := ramendrv1alpha1.ProtectedPVC{Name: "mytestpvc"}
+
+	BeforeEach(func() {
+		storageClass = &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "testing-storage-class-access-modes-",
+				Annotations: map[string]string{
+					volsync.SupportedAccessModesAnnotation: "ReadWriteOnce, ReadOnlyMany",
+				},
+			},
+			Provisioner: testStorageDriverName,
+		}
+		Expect(k8sClient.Create(ctx, storageClass)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, storageClass)
+
+		vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, nil, "none", "Snapshot", false, nil)
+	})
+
+	It("Should succeed when all requested access modes are supported", func() {
+		pvc := protectedPVC
+		pvc.StorageClassName = &storageClass.Name
+		pvc.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany}
+		Expect(vsHandler.ValidateAccessModes(pvc)).To(Succeed())
+	})
+
+	It("Should fail when a requested access mode is not supported", func() {
+		pvc := protectedPVC
+		pvc.StorageClassName = &storageClass.Name
+		pvc.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		Expect(vsHandler.ValidateAccessModes(pvc)).To(MatchError(volsync.ErrAccessModeNotSupported))
+	})
+
+	It("Should succeed when the storage class does not list its supported access modes", func() {
+		pvc := protectedPVC
+		pvc.StorageClassName = &testStorageClassName
+		pvc.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		Expect(vsHandler.ValidateAccessModes(pvc)).To(Succeed())
+	})
+})
+
+var _ = Describe("VolSync Handler - Capabilities", func() {
+	Context("When the VolSync CRDs are installed", func() {
+		It("Should detect the capabilities from the CRDs", func() {
+			vsHandler := volsync.NewVSHandler(ctx, k8sClient, logger, nil, nil, "none", "Direct", false, nil)
+
+			Expect(vsHandler.Capabilities()).To(Equal(volsync.Capabilities{
+				Detected:   true,
+				Pause:      true,
+				DirectCopy: true,
+				Retention:  true,
+			}))
+		})
+	})
+
+	Context("When the VolSync capabilities cannot be detected", func() {
+		var vsHandler *volsync.VSHandler
+
+		BeforeEach(func() {
+			// A client unable to get CRDs
+			noCRDScheme := runtime.NewScheme()
+			Expect(volsyncv1alpha1.AddToScheme(noCRDScheme)).To(Succeed())
+
+			noCRDClient, err := client.New(testEnv.Config, client.Options{Scheme: noCRDScheme})
+			Expect(err).NotTo(HaveOccurred())
+
+			vsHandler = volsync.NewVSHandler(ctx, noCRDClient, logger, &metav1.ObjectMeta{Name: "owner"}, nil,
+				"none", "Direct", false, nil)
+		})
+
+		It("Should fall back to the baseline capabilities of VolSync v0.7.1", func() {
+			Expect(vsHandler.Capabilities()).To(Equal(volsync.BaselineCapabilities))
+			Expect(vsHandler.Capabilities().Pause).To(BeTrue())
+			Expect(vsHandler.Capabilities().DirectCopy).To(BeTrue())
+		})
+
+		It("Should not refuse a ReplicationDestination with the Direct copy method", func() {
+			_, err := vsHandler.ReconcileRD(ramendrv1alpha1.VolSyncReplicationDestinationSpec{
+				ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
+					Name:               "pvc",
+					Namespace:          "ns",
+					ProtectedByVolSync: true,
+				},
+			})
+			Expect(err).NotTo(MatchError(volsync.ErrCapabilityNotSupported))
+		})
+	})
 })
 
 var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
@@ -87,7 +268,7 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 			var vsHandler *volsync.VSHandler
 
 			BeforeEach(func() {
-				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false)
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false, nil)
 			})
 
 			It("GetVolumeSnapshotClasses() should find all volume snapshot classes", func() {
@@ -104,6 +285,21 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 
 				Expect(vsClassName).To(Equal(testDefaultVolumeSnapshotClass.GetName()))
 			})
+
+			It("GetVolumeSnapshotClassFromPVCStorageClass() should return the same volume snapshot class for "+
+				"repeated lookups of storageclasses with the same driver", func() {
+				storageClassNameAandB := storageClassAandB.GetName()
+
+				for i := 0; i < 3; i++ {
+					vsClassName, err := vsHandler.GetVolumeSnapshotClassFromPVCStorageClass(&testStorageClassName)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(vsClassName).To(Equal(testDefaultVolumeSnapshotClass.GetName()))
+
+					vsClassName, err = vsHandler.GetVolumeSnapshotClassFromPVCStorageClass(&storageClassNameAandB)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(vsClassName).NotTo(BeEmpty())
+				}
+			})
 		})
 
 		Context("With simple label selector", func() {
@@ -116,7 +312,7 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 					},
 				}
 
-				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false)
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false, nil)
 			})
 
 			It("GetVolumeSnapshotClasses() should find matching volume snapshot classes", func() {
@@ -159,7 +355,7 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 					},
 				}
 
-				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false)
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec, "none", "Snapshot", false, nil)
 			})
 
 			It("GetVolumeSnapshotClasses() should find matching volume snapshot classes", func() {
@@ -180,6 +376,53 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 		})
 	})
 
+	Describe("Volume snapshot class override", func() {
+		var vsHandler *volsync.VSHandler
+		var storageClass *storagev1.StorageClass
+
+		createStorageClass := func(volumeSnapshotClassName string) {
+			storageClass = &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "testing-storage-class-vsc-override-",
+					Annotations: map[string]string{
+						volsync.VolumeSnapshotClassAnnotation: volumeSnapshotClassName,
+					},
+				},
+				Provisioner: testStorageDriverName,
+			}
+			Expect(k8sClient.Create(ctx, storageClass)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, storageClass)
+		}
+
+		BeforeEach(func() {
+			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, &ramendrv1alpha1.VRGAsyncSpec{
+				SchedulingInterval: "1h",
+			}, "none", "Snapshot", false, nil)
+		})
+
+		It("Should use the volume snapshot class that matches the storageclass provisioner", func() {
+			createStorageClass(testDefaultVolumeSnapshotClass.GetName())
+
+			Eventually(func() (string, error) {
+				return vsHandler.GetVolumeSnapshotClassFromPVCStorageClass(&storageClass.Name)
+			}, maxWait, interval).Should(Equal(testDefaultVolumeSnapshotClass.GetName()))
+		})
+
+		It("Should report a mismatch naming both the volume snapshot class and the storageclass", func() {
+			createStorageClass(volumeSnapshotClassA.GetName())
+
+			Eventually(func() error {
+				_, err := vsHandler.GetVolumeSnapshotClassFromPVCStorageClass(&storageClass.Name)
+
+				return err
+			}, maxWait, interval).Should(MatchError(volsync.ErrVolumeSnapshotClassDriverMismatch))
+
+			_, err := vsHandler.GetVolumeSnapshotClassFromPVCStorageClass(&storageClass.Name)
+			Expect(err.Error()).To(ContainSubstring(volumeSnapshotClassA.GetName()))
+			Expect(err.Error()).To(ContainSubstring(storageClass.GetName()))
+		})
+	})
+
 	Describe("ModifyRSSpecForCephFS", func() {
 		var vsHandler *volsync.VSHandler
 		var testNamespace *corev1.Namespace
@@ -216,7 +459,7 @@ var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
 
 			// Initialize a vshandler
 			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, nil, asyncSpec,
-				"openshift-storage.cephfs.csi.ceph.com", "Snapshot", false)
+				"openshift-storage.cephfs.csi.ceph.com", "Snapshot", false, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -431,7 +674,7 @@ var _ = Describe("VolSync_Handler", func() {
 		Expect(ownerCm.GetName()).NotTo(BeEmpty())
 		owner = ownerCm
 
-		vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false)
+		vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false, nil)
 	})
 
 	AfterEach(func() {
@@ -481,6 +724,59 @@ var _ = Describe("VolSync_Handler", func() {
 				})
 			})
 
+			Context("When the psk secret for volsync is synced by an external secret store", func() {
+				var pskSecret *corev1.Secret
+
+				BeforeEach(func() {
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+						&ramendrv1alpha1.VolSyncConfig{PSKSecretStore: volsync.PSKSecretStoreExternal})
+					rdSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+					pskSecret = &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName()),
+							Namespace: testNamespace.GetName(),
+						},
+					}
+				})
+
+				It("Should wait for the secret to be synced", func() {
+					rd, err := vsHandler.ReconcileRD(rdSpec)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rd).To(BeNil())
+				})
+
+				It("Should fail if the synced secret has no psk", func() {
+					Expect(k8sClient.Create(ctx, pskSecret)).To(Succeed())
+
+					Eventually(func() error {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+
+						return err
+					}, maxWait, interval).Should(MatchError(volsync.ErrPSKSecretInvalid))
+				})
+
+				It("Should create the RD without taking ownership of the synced secret", func() {
+					pskSecret.StringData = map[string]string{volsync.PSKSecretDataKey: "volsyncramen:deadbeef"}
+					Expect(k8sClient.Create(ctx, pskSecret)).To(Succeed())
+
+					Eventually(func() error {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						if err != nil {
+							return err
+						}
+
+						return k8sClient.Get(ctx, types.NamespacedName{
+							Name:      rdSpec.ProtectedPVC.Name,
+							Namespace: testNamespace.GetName(),
+						}, createdRD)
+					}, maxWait, interval).Should(Succeed())
+					Expect(*createdRD.Spec.RsyncTLS.KeySecret).To(Equal(pskSecret.GetName()))
+
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pskSecret), pskSecret)).To(Succeed())
+					Expect(pskSecret.GetOwnerReferences()).To(BeEmpty())
+				})
+			})
+
 			Context("When the psk secret for volsync exists (will be pushed down by drpc from hub", func() {
 				var dummyPSKSecret *corev1.Secret
 				JustBeforeEach(func() {
@@ -544,122 +840,451 @@ var _ = Describe("VolSync_Handler", func() {
 					})
 				})
 
-				Context("When reconciling RD with no previous RS", func() {
+				Context("When the RD cleanup was deferred while taking over as primary", func() {
+					rdKey := func() types.NamespacedName {
+						return types.NamespacedName{Name: rdSpec.ProtectedPVC.Name, Namespace: testNamespace.GetName()}
+					}
+
 					JustBeforeEach(func() {
-						// Run ReconcileRD
-						var err error
-						returnedRD, err = vsHandler.ReconcileRD(rdSpec)
+						_, err := vsHandler.ReconcileRD(rdSpec)
 						Expect(err).ToNot(HaveOccurred())
 
-						// RD should be created with name=PVCName
 						Eventually(func() error {
-							return k8sClient.Get(ctx, types.NamespacedName{
-								Name:      rdSpec.ProtectedPVC.Name,
-								Namespace: testNamespace.GetName(),
-							}, createdRD)
+							return k8sClient.Get(ctx, rdKey(), createdRD)
 						}, maxWait, interval).Should(Succeed())
 
-						// Expect the RD should be owned by owner
-						Expect(ownerMatches(createdRD, owner.GetName(), "ConfigMap", true /*should be controller*/)).To(BeTrue())
-
-						// Check common fields
-						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
-						Expect(createdRD.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
-						// Note owner here is faking out a VRG - psk key name will be based on the owner (VRG) name
-						Expect(*createdRD.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
-						Expect(*createdRD.Spec.RsyncTLS.Capacity).To(Equal(capacity))
-						Expect(createdRD.Spec.RsyncTLS.AccessModes).To(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}))
-						Expect(*createdRD.Spec.RsyncTLS.StorageClassName).To(Equal(testStorageClassName))
-						Expect(*createdRD.Spec.RsyncTLS.VolumeSnapshotClassName).To(Equal(testVolumeSnapshotClassName))
-						Expect(createdRD.Spec.Trigger).To(BeNil()) // No schedule should be set
-						Expect(createdRD.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, owner.GetName()))
-						Expect(createdRD.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
-						Expect(*createdRD.Spec.RsyncTLS.ServiceType).To(Equal(volsync.DefaultRsyncServiceType))
-
-						// Check that the secret has been updated to have our vrg as owner
-						Eventually(func() bool {
-							err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dummyPSKSecret), dummyPSKSecret)
-							if err != nil {
-								return false
-							}
+						Expect(vsHandler.DeferRDCleanup(rdSpec.ProtectedPVC.Name, testNamespace.GetName())).To(Succeed())
+					})
 
-							// The psk secret should be updated to be owned by the VRG
-							return ownerMatches(dummyPSKSecret, owner.GetName(), "ConfigMap", false)
-						}, maxWait, interval).Should(BeTrue())
+					It("Should keep the RD paused, pending the takeover", func() {
+						Eventually(func(g Gomega) {
+							g.Expect(k8sClient.Get(ctx, rdKey(), createdRD)).To(Succeed())
+							g.Expect(createdRD.GetAnnotations()).To(HaveKey(volsync.TakeoverPendingAnnotation))
+							g.Expect(createdRD.Spec.Paused).To(BeTrue())
+						}, maxWait, interval).Should(Succeed())
+					})
 
-						// Check that the service export is created for this RD
-						svcExport := &unstructured.Unstructured{}
-						svcExport.SetGroupVersionKind(schema.GroupVersionKind{
-							Group:   volsync.ServiceExportGroup,
-							Kind:    volsync.ServiceExportKind,
-							Version: volsync.ServiceExportVersion,
-						})
-						Eventually(func() error {
-							return k8sClient.Get(ctx, client.ObjectKey{
-								Name:      fmt.Sprintf("volsync-rsync-tls-dst-%s", createdRD.GetName()),
-								Namespace: createdRD.GetNamespace(),
-							}, svcExport)
+					It("Should resume the RD if the takeover is rolled back", func() {
+						Eventually(func(g Gomega) {
+							_, err := vsHandler.ReconcileRD(rdSpec)
+							g.Expect(err).ToNot(HaveOccurred())
+							g.Expect(k8sClient.Get(ctx, rdKey(), createdRD)).To(Succeed())
+							g.Expect(createdRD.GetAnnotations()).NotTo(HaveKey(volsync.TakeoverPendingAnnotation))
+							g.Expect(createdRD.Spec.Paused).To(BeFalse())
 						}, maxWait, interval).Should(Succeed())
+					})
+				})
 
-						// The created service export should be owned by the replication destination, not our VRG
-						Expect(ownerMatches(svcExport, createdRD.GetName(), "ReplicationDestination", false)).To(BeTrue())
+				Context("When a minimum protected PVC size is configured", func() {
+					var minSize resource.Quantity
+
+					JustBeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{MinProtectedPVCSize: &minSize})
 					})
 
-					Context("When replication destination already exists with status.address specified", func() {
-						myTestAddress := "https://fakeaddress.abc.org:8888"
+					Context("When the PVC is just under the minimum size", func() {
 						BeforeEach(func() {
-							// Pre-create a replication destination - and fill out Status.Address
-							rdPrecreate := &volsyncv1alpha1.ReplicationDestination{
-								ObjectMeta: metav1.ObjectMeta{
+							minSize = resource.MustParse("2049Mi")
+						})
+
+						It("Should skip the PVC and not create an RD", func() {
+							rd, err := vsHandler.ReconcileRD(rdSpec)
+							Expect(err).To(MatchError(volsync.ErrPVCBelowMinSize))
+							Expect(rd).To(BeNil())
+
+							Consistently(func() error {
+								return k8sClient.Get(ctx, types.NamespacedName{
 									Name:      rdSpec.ProtectedPVC.Name,
 									Namespace: testNamespace.GetName(),
-								},
-								// Empty spec - will expect the reconcile to fill this out properly for us (i.e. update)
-								Spec: volsyncv1alpha1.ReplicationDestinationSpec{},
-							}
-							Expect(k8sClient.Create(ctx, rdPrecreate)).To(Succeed())
+								}, createdRD)
+							}, 1*time.Second, interval).ShouldNot(Succeed())
+						})
+					})
+
+					Context("When the PVC is just over the minimum size", func() {
+						BeforeEach(func() {
+							minSize = resource.MustParse("2047Mi")
+						})
+
+						It("Should create the RD", func() {
+							_, err := vsHandler.ReconcileRD(rdSpec)
+							Expect(err).ToNot(HaveOccurred())
 
-							//
-							// Make sure the RD is created and update Status to set an address
-							// (Simulating what the volsync controller would do)
-							//
 							Eventually(func() error {
-								return k8sClient.Get(ctx, client.ObjectKeyFromObject(rdPrecreate), rdPrecreate)
+								return k8sClient.Get(ctx, types.NamespacedName{
+									Name:      rdSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								}, createdRD)
 							}, maxWait, interval).Should(Succeed())
+						})
+					})
+				})
 
-							// Fake the address and latestImage in the status
-							rdPrecreate.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
-								RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{
-									Address: &myTestAddress,
-								},
-							}
-							Expect(k8sClient.Status().Update(ctx, rdPrecreate)).To(Succeed())
-							Eventually(func() *string {
-								err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rdPrecreate), rdPrecreate)
-								if err != nil || rdPrecreate.Status == nil || rdPrecreate.Status.RsyncTLS == nil {
-									return nil
-								}
+				Context("When a mover service account is configured", func() {
+					moverSAName := "restricted-mover"
 
-								return rdPrecreate.Status.RsyncTLS.Address
-							}, maxWait, interval).Should(Not(BeNil()))
-						})
+					BeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{MoverServiceAccountName: moverSAName})
+					})
 
-						It("Should properly update Replication destination and return rd", func() {
-							// Common JustBeforeEach will run reconcileRD and check spec is proper
-							// Expect RDInfo to NOT be nil - address was filled out so it should have been returned
-							Expect(returnedRD).ToNot(BeNil())
+					Context("When the service account does not exist", func() {
+						It("Should fail and not create an RD", func() {
+							rd, err := vsHandler.ReconcileRD(rdSpec)
+							Expect(err).To(MatchError(volsync.ErrMoverServiceAccountMissing))
+							Expect(rd).To(BeNil())
+
+							Consistently(func() error {
+								return k8sClient.Get(ctx, types.NamespacedName{
+									Name:      rdSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								}, createdRD)
+							}, 1*time.Second, interval).ShouldNot(Succeed())
 						})
 					})
-				})
-			})
 
-			Context("With CopyMethod 'Direct'", func() {
-				var vsHandler *volsync.VSHandler
+					Context("When the service account exists", func() {
+						BeforeEach(func() {
+							Expect(k8sClient.Create(ctx, &corev1.ServiceAccount{
+								ObjectMeta: metav1.ObjectMeta{Name: moverSAName, Namespace: testNamespace.GetName()},
+							})).To(Succeed())
+						})
 
-				BeforeEach(func() {
-					rdSpec.ProtectedPVC.Namespace = testNamespace.GetName()
-					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Direct", false)
-				})
+						It("Should create the RD with movers running as the service account", func() {
+							_, err := vsHandler.ReconcileRD(rdSpec)
+							Expect(err).ToNot(HaveOccurred())
+
+							Eventually(func() error {
+								return k8sClient.Get(ctx, types.NamespacedName{
+									Name:      rdSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								}, createdRD)
+							}, maxWait, interval).Should(Succeed())
+
+							Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+							Expect(createdRD.Spec.RsyncTLS.MoverServiceAccount).To(HaveValue(Equal(moverSAName)))
+						})
+					})
+				})
+
+				Context("When mover service account fallbacks are configured", func() {
+					moverSAName := "restricted-mover"
+					fallbackSANames := []string{"restricted-mover-fallback", "default-mover"}
+
+					saCreate := func(saName string) {
+						Expect(k8sClient.Create(ctx, &corev1.ServiceAccount{
+							ObjectMeta: metav1.ObjectMeta{Name: saName, Namespace: testNamespace.GetName()},
+						})).To(Succeed())
+					}
+					rdMoverServiceAccountExpect := func(saName string) {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+
+						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+						Expect(createdRD.Spec.RsyncTLS.MoverServiceAccount).To(HaveValue(Equal(saName)))
+					}
+
+					BeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{
+								MoverServiceAccountName:          moverSAName,
+								MoverServiceAccountFallbackNames: fallbackSANames,
+							})
+					})
+
+					It("Should fail and not create an RD if none of the service accounts exist", func() {
+						rd, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).To(MatchError(volsync.ErrMoverServiceAccountMissing))
+						Expect(rd).To(BeNil())
+					})
+
+					It("Should use the first fallback that exists while the service account does not", func() {
+						saCreate(fallbackSANames[1])
+						rdMoverServiceAccountExpect(fallbackSANames[1])
+					})
+
+					It("Should prefer the service account over its fallbacks once it exists", func() {
+						saCreate(fallbackSANames[0])
+						saCreate(moverSAName)
+						rdMoverServiceAccountExpect(moverSAName)
+					})
+				})
+
+				Context("When reconciling RD with replication annotations configured", func() {
+					BeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{ReplicationAnnotations: map[string]string{
+								"example.com/cost-center":   "1234",
+								volsync.OwnerNameAnnotation: "not-the-owner",
+							}})
+					})
+
+					JustBeforeEach(func() {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+					})
+
+					It("Should add the replication annotations without overwriting Ramen's", func() {
+						Expect(createdRD.GetAnnotations()).To(HaveKeyWithValue("example.com/cost-center", "1234"))
+						Expect(createdRD.GetAnnotations()).To(HaveKeyWithValue(volsync.OwnerNameAnnotation, owner.GetName()))
+						Expect(createdRD.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, owner.GetName()))
+						Expect(ownerMatches(createdRD, owner.GetName(), "ConfigMap", true /*should be controller*/)).To(BeTrue())
+						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+						Expect(createdRD.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
+						Expect(*createdRD.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
+					})
+				})
+
+				Context("When reconciling RD again with nothing changed", func() {
+					JustBeforeEach(func() {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+					})
+
+					It("Should report the RD created, then unchanged", func() {
+						summary := vsHandler.ReconcileSummary()
+						Expect(summary).To(Equal(volsync.ReconcileSummary{Created: 1}))
+						Expect(summary.Changed()).To(BeTrue())
+
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false, nil)
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						summary = vsHandler.ReconcileSummary()
+						Expect(summary).To(Equal(volsync.ReconcileSummary{Unchanged: 1}))
+						Expect(summary.Changed()).To(BeFalse())
+					})
+				})
+
+				Context("When reconciling RD with a field manager configured", func() {
+					BeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{FieldManager: "test-field-manager"})
+					})
+
+					It("Should attribute the managed fields of the RD to the field manager", func() {
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+
+						managers := []string{}
+						for _, entry := range createdRD.GetManagedFields() {
+							managers = append(managers, entry.Manager)
+						}
+						Expect(managers).To(ContainElement("test-field-manager"))
+						Expect(managers).NotTo(ContainElement(volsync.FieldManagerName))
+					})
+				})
+
+				Context("When reconciling RD with server-side apply enabled", func() {
+					BeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{ServerSideApply: true})
+					})
+
+					JustBeforeEach(func() {
+						var err error
+						returnedRD, err = vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+					})
+
+					It("Should apply only the fields owned by ramen", func() {
+						Expect(ownerMatches(createdRD, owner.GetName(), "ConfigMap", true /*should be controller*/)).To(BeTrue())
+						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+						Expect(*createdRD.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
+
+						var appliedFields *metav1.ManagedFieldsEntry
+						for i := range createdRD.GetManagedFields() {
+							entry := &createdRD.GetManagedFields()[i]
+							if entry.Manager == volsync.FieldManagerName && entry.Operation == metav1.ManagedFieldsOperationApply {
+								appliedFields = entry
+							}
+						}
+						Expect(appliedFields).NotTo(BeNil())
+						Expect(appliedFields.FieldsV1).NotTo(BeNil())
+
+						fields := string(appliedFields.FieldsV1.Raw)
+						Expect(fields).To(ContainSubstring(`"f:rsyncTLS"`))
+						Expect(fields).To(ContainSubstring(`"f:` + volsync.VRGOwnerNameLabel + `"`))
+						Expect(fields).NotTo(ContainSubstring(`"f:paused"`))
+						Expect(fields).NotTo(ContainSubstring(`"f:trigger"`))
+						Expect(fields).NotTo(ContainSubstring(`"f:status"`))
+					})
+
+					It("Should report the RD unchanged when applied again", func() {
+						Expect(vsHandler.ReconcileSummary()).To(Equal(volsync.ReconcileSummary{Created: 1}))
+
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(vsHandler.ReconcileSummary()).To(Equal(volsync.ReconcileSummary{Created: 1, Unchanged: 1}))
+					})
+
+					It("Should not overwrite fields set by others when applied again", func() {
+						createdRD.Spec.Paused = true
+						Expect(k8sClient.Update(ctx, createdRD)).To(Succeed())
+
+						_, err := vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRD), createdRD)).To(Succeed())
+						Expect(createdRD.Spec.Paused).To(BeTrue())
+						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+					})
+				})
+
+				Context("When reconciling RD with no previous RS", func() {
+					JustBeforeEach(func() {
+						// Run ReconcileRD
+						var err error
+						returnedRD, err = vsHandler.ReconcileRD(rdSpec)
+						Expect(err).ToNot(HaveOccurred())
+
+						// RD should be created with name=PVCName
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rdSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRD)
+						}, maxWait, interval).Should(Succeed())
+
+						// Expect the RD should be owned by owner
+						Expect(ownerMatches(createdRD, owner.GetName(), "ConfigMap", true /*should be controller*/)).To(BeTrue())
+
+						// Check common fields
+						Expect(createdRD.Spec.RsyncTLS).NotTo(BeNil())
+						Expect(createdRD.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
+						// Note owner here is faking out a VRG - psk key name will be based on the owner (VRG) name
+						Expect(*createdRD.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
+						Expect(*createdRD.Spec.RsyncTLS.Capacity).To(Equal(capacity))
+						Expect(createdRD.Spec.RsyncTLS.AccessModes).To(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}))
+						Expect(*createdRD.Spec.RsyncTLS.StorageClassName).To(Equal(testStorageClassName))
+						// Movers run as the default service account VolSync creates
+						Expect(createdRD.Spec.RsyncTLS.MoverServiceAccount).To(BeNil())
+						Expect(*createdRD.Spec.RsyncTLS.VolumeSnapshotClassName).To(Equal(testVolumeSnapshotClassName))
+						Expect(createdRD.Spec.Trigger).To(BeNil()) // No schedule should be set
+						Expect(createdRD.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, owner.GetName()))
+						Expect(createdRD.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
+						Expect(*createdRD.Spec.RsyncTLS.ServiceType).To(Equal(volsync.DefaultRsyncServiceType))
+
+						// Check that the secret has been updated to have our vrg as owner
+						Eventually(func() bool {
+							err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dummyPSKSecret), dummyPSKSecret)
+							if err != nil {
+								return false
+							}
+
+							// The psk secret should be updated to be owned by the VRG
+							return ownerMatches(dummyPSKSecret, owner.GetName(), "ConfigMap", false)
+						}, maxWait, interval).Should(BeTrue())
+
+						// Check that the service export is created for this RD
+						svcExport := &unstructured.Unstructured{}
+						svcExport.SetGroupVersionKind(schema.GroupVersionKind{
+							Group:   volsync.ServiceExportGroup,
+							Kind:    volsync.ServiceExportKind,
+							Version: volsync.ServiceExportVersion,
+						})
+						Eventually(func() error {
+							return k8sClient.Get(ctx, client.ObjectKey{
+								Name:      fmt.Sprintf("volsync-rsync-tls-dst-%s", createdRD.GetName()),
+								Namespace: createdRD.GetNamespace(),
+							}, svcExport)
+						}, maxWait, interval).Should(Succeed())
+
+						// The created service export should be owned by the replication destination, not our VRG
+						Expect(ownerMatches(svcExport, createdRD.GetName(), "ReplicationDestination", false)).To(BeTrue())
+					})
+
+					Context("When replication destination already exists with status.address specified", func() {
+						myTestAddress := "https://fakeaddress.abc.org:8888"
+						BeforeEach(func() {
+							// Pre-create a replication destination - and fill out Status.Address
+							rdPrecreate := &volsyncv1alpha1.ReplicationDestination{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      rdSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								},
+								// Empty spec - will expect the reconcile to fill this out properly for us (i.e. update)
+								Spec: volsyncv1alpha1.ReplicationDestinationSpec{},
+							}
+							Expect(k8sClient.Create(ctx, rdPrecreate)).To(Succeed())
+
+							//
+							// Make sure the RD is created and update Status to set an address
+							// (Simulating what the volsync controller would do)
+							//
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(rdPrecreate), rdPrecreate)
+							}, maxWait, interval).Should(Succeed())
+
+							// Fake the address and latestImage in the status
+							rdPrecreate.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+								RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{
+									Address: &myTestAddress,
+								},
+							}
+							Expect(k8sClient.Status().Update(ctx, rdPrecreate)).To(Succeed())
+							Eventually(func() *string {
+								err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rdPrecreate), rdPrecreate)
+								if err != nil || rdPrecreate.Status == nil || rdPrecreate.Status.RsyncTLS == nil {
+									return nil
+								}
+
+								return rdPrecreate.Status.RsyncTLS.Address
+							}, maxWait, interval).Should(Not(BeNil()))
+						})
+
+						It("Should properly update Replication destination and return rd", func() {
+							// Common JustBeforeEach will run reconcileRD and check spec is proper
+							// Expect RDInfo to NOT be nil - address was filled out so it should have been returned
+							Expect(returnedRD).ToNot(BeNil())
+						})
+					})
+				})
+			})
+
+			Context("With CopyMethod 'Direct'", func() {
+				var vsHandler *volsync.VSHandler
+
+				BeforeEach(func() {
+					rdSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Direct", false, nil)
+				})
 
 				It("PrecreateDestPVCIfEnabled() should return CopyMethod Snapshot and App PVC name", func() {
 					dstPVC, err := vsHandler.PrecreateDestPVCIfEnabled(rdSpec)
@@ -748,22 +1373,199 @@ var _ = Describe("VolSync_Handler", func() {
 					}, maxWait, interval).Should(Succeed())
 				})
 
-				Context("When no running pod is mounting the PVC to be protected", func() {
-					It("Should return a nil replication source and no RS should be created", func() {
-						// Run another reconcile - we have the psk secret now but the pvc is not in use by
-						// a running pod
-						finalSyncCompl, rs, err := vsHandler.ReconcileRS(rsSpec, false)
-						Expect(err).ToNot(HaveOccurred())
-						Expect(finalSyncCompl).To(BeFalse())
-						Expect(rs).To(BeNil())
+				Context("When a minimum protected PVC size is configured", func() {
+					var minSize resource.Quantity
 
-						// ReconcileRS should not have created the replication source - since the secret isn't there
-						Consistently(func() error {
-							return k8sClient.Get(ctx,
-								types.NamespacedName{Name: rsSpec.ProtectedPVC.Name, Namespace: testNamespace.GetName()}, createdRS)
-						}, 1*time.Second, interval).ShouldNot(BeNil())
+					JustBeforeEach(func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+							&ramendrv1alpha1.VolSyncConfig{MinProtectedPVCSize: &minSize})
 					})
-				})
+
+					Context("When the PVC is just under the minimum size", func() {
+						BeforeEach(func() {
+							minSize = resource.MustParse("3073Mi")
+						})
+
+						It("Should skip the PVC and not create an RS", func() {
+							finalSyncDone, rs, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).To(MatchError(volsync.ErrPVCBelowMinSize))
+							Expect(finalSyncDone).To(BeFalse())
+							Expect(rs).To(BeNil())
+
+							Consistently(func() error {
+								return k8sClient.Get(ctx, types.NamespacedName{
+									Name:      rsSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								}, createdRS)
+							}, 1*time.Second, interval).ShouldNot(Succeed())
+						})
+					})
+
+					Context("When the PVC is just over the minimum size", func() {
+						BeforeEach(func() {
+							minSize = resource.MustParse("3071Mi")
+						})
+
+						It("Should not skip the PVC", func() {
+							_, _, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).NotTo(MatchError(volsync.ErrPVCBelowMinSize))
+						})
+					})
+				})
+
+				Context("When the source PVC of an existing replication source is missing", func() {
+					var rs *volsyncv1alpha1.ReplicationSource
+					JustBeforeEach(func() {
+						rs = &volsyncv1alpha1.ReplicationSource{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      rsSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+								Labels: map[string]string{
+									volsync.VRGOwnerNameLabel:      owner.GetName(),
+									volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+								},
+							},
+							Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: rsSpec.ProtectedPVC.Name},
+						}
+						Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+						Eventually(func() error {
+							return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+						}, maxWait, interval).Should(Succeed())
+					})
+
+					It("Should report the missing PVC and keep the RS by default", func() {
+						finalSyncDone, _, err := vsHandler.ReconcileRS(rsSpec, false)
+						Expect(err).To(MatchError(volsync.ErrSourcePVCMissing))
+						Expect(finalSyncDone).To(BeFalse())
+
+						Consistently(func() error {
+							return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+						}, 1*time.Second, interval).Should(Succeed())
+					})
+
+					It("Should report the missing PVC and delete the RS when configured to", func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+							false, &ramendrv1alpha1.VolSyncConfig{
+								SourcePVCMissingAction: volsync.SourcePVCMissingActionDelete,
+							})
+
+						_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+						Expect(err).To(MatchError(volsync.ErrSourcePVCMissing))
+						Expect(returnedRS).To(BeNil())
+
+						Eventually(func() bool {
+							return kerrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs))
+						}, maxWait, interval).Should(BeTrue())
+					})
+
+					It("Should report the missing PVC, no longer listed, and keep the RS by default", func() {
+						missing, err := vsHandler.HandleMissingSourcePVCs(nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(missing).To(ConsistOf(types.NamespacedName{
+							Name:      rsSpec.ProtectedPVC.Name,
+							Namespace: testNamespace.GetName(),
+						}))
+
+						Consistently(func() error {
+							return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+						}, 1*time.Second, interval).Should(Succeed())
+					})
+
+					It("Should report the missing PVC, no longer listed, and delete the RS when configured to", func() {
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+							false, &ramendrv1alpha1.VolSyncConfig{
+								SourcePVCMissingAction: volsync.SourcePVCMissingActionDelete,
+							})
+
+						missing, err := vsHandler.HandleMissingSourcePVCs(nil)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(missing).To(HaveLen(1))
+
+						Eventually(func() bool {
+							return kerrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs))
+						}, maxWait, interval).Should(BeTrue())
+					})
+
+					It("Should not report the RS of a listed PVC", func() {
+						missing, err := vsHandler.HandleMissingSourcePVCs([]types.NamespacedName{
+							{Name: rsSpec.ProtectedPVC.Name, Namespace: testNamespace.GetName()},
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(missing).To(BeEmpty())
+					})
+				})
+
+				Context("When the PVC to be protected is being deleted", func() {
+					JustBeforeEach(func() {
+						markPVCTerminating(createDummyPVC(testPVCName, testNamespace.GetName(), capacity, nil))
+					})
+
+					It("Should report the terminating PVC and not create an RS", func() {
+						finalSyncDone, rs, err := vsHandler.ReconcileRS(rsSpec, false)
+						Expect(err).To(MatchError(volsync.ErrPVCTerminating))
+						Expect(finalSyncDone).To(BeFalse())
+						Expect(rs).To(BeNil())
+
+						Consistently(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      rsSpec.ProtectedPVC.Name,
+								Namespace: testNamespace.GetName(),
+							}, createdRS)
+						}, 1*time.Second, interval).ShouldNot(Succeed())
+					})
+
+					Context("When the final sync of the PVC is complete", func() {
+						JustBeforeEach(func() {
+							rs := &volsyncv1alpha1.ReplicationSource{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      rsSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								},
+								Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: rsSpec.ProtectedPVC.Name},
+							}
+							Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+							rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+								LastManualSync: volsync.FinalSyncTriggerString,
+							}
+							Expect(k8sClient.Status().Update(ctx, rs)).To(Succeed())
+
+							Eventually(func() string {
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+								if rs.Status == nil {
+									return ""
+								}
+
+								return rs.Status.LastManualSync
+							}, maxWait, interval).Should(Equal(volsync.FinalSyncTriggerString))
+						})
+
+						It("Should report the final sync complete", func() {
+							finalSyncDone, rs, err := vsHandler.ReconcileRS(rsSpec, true)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(finalSyncDone).To(BeTrue())
+							Expect(rs).NotTo(BeNil())
+						})
+					})
+				})
+
+				Context("When no running pod is mounting the PVC to be protected", func() {
+					It("Should return a nil replication source and no RS should be created", func() {
+						// Run another reconcile - we have the psk secret now but the pvc is not in use by
+						// a running pod
+						finalSyncCompl, rs, err := vsHandler.ReconcileRS(rsSpec, false)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(finalSyncCompl).To(BeFalse())
+						Expect(rs).To(BeNil())
+
+						// ReconcileRS should not have created the replication source - since the secret isn't there
+						Consistently(func() error {
+							return k8sClient.Get(ctx,
+								types.NamespacedName{Name: rsSpec.ProtectedPVC.Name, Namespace: testNamespace.GetName()}, createdRS)
+						}, 1*time.Second, interval).ShouldNot(BeNil())
+					})
+				})
 
 				Context("When the PVC to be protected is mounted by a pod that is NOT in running phase", func() {
 					JustBeforeEach(func() {
@@ -810,6 +1612,85 @@ var _ = Describe("VolSync_Handler", func() {
 					})
 				})
 
+				Context("When the PVC restored on failover or relocation is pending", func() {
+					var pvcStorageClassName string
+
+					JustBeforeEach(func() {
+						pvc := &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{Name: testPVCName, Namespace: testNamespace.GetName()},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								StorageClassName: &pvcStorageClassName,
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: capacity},
+								},
+							},
+						}
+						Expect(k8sClient.Create(ctx, pvc)).To(Succeed())
+
+						pvc.Status.Phase = corev1.ClaimPending
+						Expect(k8sClient.Status().Update(ctx, pvc)).To(Succeed())
+
+						Eventually(func() corev1.PersistentVolumeClaimPhase {
+							Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+
+							return pvc.Status.Phase
+						}, maxWait, interval).Should(Equal(corev1.ClaimPending))
+
+						createDummyPodMountingPVC(pvc, corev1.PodRunning, true /* pod should be Ready */)
+
+						rsSpec.ProtectedPVC.StorageClassName = &pvcStorageClassName
+						DeferCleanup(func() {
+							rsSpec.ProtectedPVC.StorageClassName = &testStorageClassName
+						})
+					})
+
+					Context("When the storage class binds volumes immediately", func() {
+						BeforeEach(func() {
+							pvcStorageClassName = testStorageClassName
+						})
+
+						It("Should wait for the PVC to be bound before creating the RS", func() {
+							Consistently(func() *volsyncv1alpha1.ReplicationSource {
+								_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+
+								return returnedRS
+							}, 1*time.Second, interval).Should(BeNil())
+						})
+					})
+
+					Context("When the storage class waits for the first consumer to bind volumes", func() {
+						BeforeEach(func() {
+							bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+							storageClass := &storagev1.StorageClass{
+								ObjectMeta: metav1.ObjectMeta{
+									GenerateName: "test-wffc-storageclass-",
+								},
+								Provisioner:       testStorageDriverName,
+								VolumeBindingMode: &bindingMode,
+							}
+							Expect(k8sClient.Create(ctx, storageClass)).To(Succeed())
+							DeferCleanup(k8sClient.Delete, ctx, storageClass)
+
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(storageClass), storageClass)
+							}, maxWait, interval).Should(Succeed())
+
+							pvcStorageClassName = storageClass.GetName()
+						})
+
+						It("Should accept the pending PVC and create the RS", func() {
+							Eventually(func() *volsyncv1alpha1.ReplicationSource {
+								_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+
+								return returnedRS
+							}, maxWait, interval).ShouldNot(BeNil())
+						})
+					})
+				})
+
 				Context("When the PVC to be protected is mounted by a running and Ready pod", func() {
 					var podMountingPVC *corev1.Pod
 					var testPVC *corev1.PersistentVolumeClaim
@@ -845,10 +1726,14 @@ var _ = Describe("VolSync_Handler", func() {
 							}, maxWait, interval).Should(Succeed())
 
 							// Run ReconcileRS again - Not running final sync so this should return false
-							finalSyncDone, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
-							Expect(err).ToNot(HaveOccurred())
-							Expect(finalSyncDone).To(BeFalse())
-							Expect(returnedRS).NotTo(BeNil())
+							// The RS is only created once the RD is confirmed gone, so reconcile until it is returned
+							Eventually(func() *volsyncv1alpha1.ReplicationSource {
+								finalSyncDone, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(finalSyncDone).To(BeFalse())
+
+								return returnedRS
+							}, maxWait, interval).ShouldNot(BeNil())
 
 							// RS should be created with name=PVCName
 							Eventually(func() error {
@@ -871,74 +1756,441 @@ var _ = Describe("VolSync_Handler", func() {
 						})
 					})
 
-					Context("When reconciling RS with no previous RD", func() {
-						var returnedRS *volsyncv1alpha1.ReplicationSource
-
+					Context("When a RD for the pvc to protect is still being deleted", func() {
+						var rd *volsyncv1alpha1.ReplicationDestination
 						JustBeforeEach(func() {
-							finalSyncDone := false
-							var err error
+							// Pre-create an RD with a finalizer so that it lingers after being deleted
+							rd = &volsyncv1alpha1.ReplicationDestination{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      rsSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+									Labels: map[string]string{
+										volsync.VRGOwnerNameLabel:      owner.GetName(),
+										volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+									},
+									Finalizers: []string{"test.ramendr.openshift.io/hold"},
+								},
+								Spec: volsyncv1alpha1.ReplicationDestinationSpec{},
+							}
+							Expect(k8sClient.Create(ctx, rd)).To(Succeed())
 
-							// Run ReconcileRS - Not running final sync so this should return false
-							finalSyncDone, returnedRS, err = vsHandler.ReconcileRS(rsSpec, false)
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+							}, maxWait, interval).Should(Succeed())
+						})
+
+						It("Should not create the RS until the ReplicationDestination is gone", func() {
+							finalSyncDone, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
 							Expect(err).ToNot(HaveOccurred())
 							Expect(finalSyncDone).To(BeFalse())
+							Expect(returnedRS).To(BeNil())
 
-							// RS should be created with name=PVCName and owner is our vrg
-							Eventually(func() bool {
-								err := k8sClient.Get(ctx,
-									types.NamespacedName{
-										Name:      rsSpec.ProtectedPVC.Name,
-										Namespace: testNamespace.GetName(),
-									},
-									createdRS)
-								if err != nil {
-									return false
+							Consistently(func() error {
+								return k8sClient.Get(ctx, types.NamespacedName{
+									Name:      rsSpec.ProtectedPVC.Name,
+									Namespace: testNamespace.GetName(),
+								}, createdRS)
+							}, 1*time.Second, interval).ShouldNot(BeNil())
+
+							// Release the RD, the RS should then be created
+							Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)).To(Succeed())
+							rd.SetFinalizers(nil)
+							Expect(k8sClient.Update(ctx, rd)).To(Succeed())
+
+							Eventually(func() *volsyncv1alpha1.ReplicationSource {
+								_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+
+								return returnedRS
+							}, maxWait, interval).ShouldNot(BeNil())
+						})
+					})
+
+					Context("When the concurrent syncs of the VRG are capped", func() {
+						BeforeEach(func() {
+							vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+								false, &ramendrv1alpha1.VolSyncConfig{MaxConcurrentSyncs: 1})
+						})
+
+						It("Should pause all but one RS, and resume the next once its sync completes", func() {
+							rsSpecs := []ramendrv1alpha1.VolSyncReplicationSourceSpec{rsSpec}
+							for _, pvcName := range []string{"mytestpvc-throttled-1", "mytestpvc-throttled-2"} {
+								createDummyPVCAndMountingPod(pvcName, testNamespace.GetName(), capacity, nil,
+									corev1.PodRunning, true /* pod should be Ready */)
+
+								throttledSpec := rsSpec
+								throttledSpec.ProtectedPVC.Name = pvcName
+								rsSpecs = append(rsSpecs, throttledSpec)
+							}
+
+							reconcileAll := func() {
+								pvcs := []types.NamespacedName{}
+								for _, spec := range rsSpecs {
+									pvcs = append(pvcs, types.NamespacedName{
+										Name: spec.ProtectedPVC.Name, Namespace: spec.ProtectedPVC.Namespace,
+									})
 								}
+								Expect(vsHandler.AssignSyncSlots(pvcs)).To(Succeed())
 
-								return ownerMatches(createdRS, owner.GetName(), "ConfigMap",
-									true /* Should be controller */)
-							}, maxWait, interval).Should(BeTrue())
+								for _, spec := range rsSpecs {
+									_, _, err := vsHandler.ReconcileRS(spec, false)
+									Expect(err).ToNot(HaveOccurred())
+								}
+							}
 
-							// Check that the volsync psk secret has been updated to have our vrg as owner
-							Eventually(func() bool {
-								err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dummyPSKSecret), dummyPSKSecret)
-								if err != nil {
-									return false
+							unpausedRS := func() []string {
+								rsList := &volsyncv1alpha1.ReplicationSourceList{}
+								Expect(k8sClient.List(ctx, rsList, client.InNamespace(testNamespace.GetName()))).To(Succeed())
+
+								unpaused := []string{}
+								for _, rs := range rsList.Items {
+									if !rs.Spec.Paused {
+										unpaused = append(unpaused, rs.GetName())
+									}
 								}
 
-								// The psk secret should be updated to be owned by the VRG
-								return ownerMatches(dummyPSKSecret, owner.GetName(), "ConfigMap", false)
-							}, maxWait, interval).Should(BeTrue())
+								return unpaused
+							}
 
-							// Check common fields
-							Expect(createdRS.Spec.SourcePVC).To(Equal(rsSpec.ProtectedPVC.Name))
-							Expect(createdRS.Spec.RsyncTLS).NotTo(BeNil())
-							Expect(createdRS.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
-							// Note owner here is faking out a VRG - psk secret name will be based on the owner (VRG) name
-							Expect(*createdRS.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
-							Expect(*createdRS.Spec.RsyncTLS.Address).To(Equal("volsync-rsync-tls-dst-" +
-								rsSpec.ProtectedPVC.Name + "." + testNamespace.GetName() + ".svc.clusterset.local"))
+							Eventually(func() []string {
+								reconcileAll()
 
-							Expect(*createdRS.Spec.RsyncTLS.VolumeSnapshotClassName).To(Equal(testVolumeSnapshotClassName))
+								return unpausedRS()
+							}, maxWait, interval).Should(ConsistOf(rsSpecs[0].ProtectedPVC.Name))
 
-							Expect(createdRS.Spec.Trigger).ToNot(BeNil())
-							Expect(createdRS.Spec.Trigger).To(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
-								Schedule: &expectedCronSpecSchedule,
-							}))
-							Expect(createdRS.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, owner.GetName()))
-							Expect(createdRS.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
+							// Fake out the completion of the sync of the unpaused RS
+							syncedRS := &volsyncv1alpha1.ReplicationSource{}
+							Expect(k8sClient.Get(ctx, types.NamespacedName{
+								Name: rsSpecs[0].ProtectedPVC.Name, Namespace: testNamespace.GetName(),
+							}, syncedRS)).To(Succeed())
+							syncedRS.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+								LastSyncTime: &metav1.Time{Time: time.Now()},
+							}
+							Expect(k8sClient.Status().Update(ctx, syncedRS)).To(Succeed())
+
+							Eventually(func() []string {
+								reconcileAll()
+
+								return unpausedRS()
+							}, maxWait, interval).Should(ConsistOf(rsSpecs[1].ProtectedPVC.Name))
 						})
 
-						It("Should create an ReplicationSource if one does not exist", func() {
-							// All checks here performed in the JustBeforeEach(common checks)
+						It("Should not resume an RS paused by someone else", func() {
+							pvcs := []types.NamespacedName{{
+								Name: rsSpec.ProtectedPVC.Name, Namespace: rsSpec.ProtectedPVC.Namespace,
+							}}
+							Expect(vsHandler.AssignSyncSlots(pvcs)).To(Succeed())
+
+							_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
 							Expect(returnedRS).NotTo(BeNil())
+
+							createdRS := &volsyncv1alpha1.ReplicationSource{}
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(returnedRS), createdRS)
+							}, maxWait, interval).Should(Succeed())
+							Expect(createdRS.Spec.Paused).To(BeFalse())
+
+							createdRS.Spec.Paused = true
+							Expect(k8sClient.Update(ctx, createdRS)).To(Succeed())
+
+							Expect(vsHandler.AssignSyncSlots(pvcs)).To(Succeed())
+							_, _, err = vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+
+							Consistently(func() bool {
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+
+								return createdRS.Spec.Paused
+							}, 2*time.Second, interval).Should(BeTrue())
+							Expect(createdRS.GetAnnotations()).NotTo(HaveKey(volsync.SyncThrottledAnnotation))
 						})
+					})
 
-						Context("When replication source already exists", func() {
-							var rsPrecreate *volsyncv1alpha1.ReplicationSource
+					Context("When the first sync is configured to run immediately", func() {
+						BeforeEach(func() {
+							vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+								false, &ramendrv1alpha1.VolSyncConfig{InitialSyncImmediate: true})
+						})
 
-							BeforeEach(func() {
-								// Pre-create a replication destination - and fill out Status.Address
+						It("Should trigger the initial sync manually, then switch to the schedule", func() {
+							_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(returnedRS).NotTo(BeNil())
+
+							createdRS := &volsyncv1alpha1.ReplicationSource{}
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(returnedRS), createdRS)
+							}, maxWait, interval).Should(Succeed())
+							Expect(createdRS.Spec.Trigger).To(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
+								Manual: volsync.InitialSyncTriggerString,
+							}))
+
+							// Reconciling again before the initial sync completes keeps the manual trigger
+							_, _, err = vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+							Expect(createdRS.Spec.Trigger.Manual).To(Equal(volsync.InitialSyncTriggerString))
+
+							// Fake out the completion of the initial sync
+							createdRS.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+								LastManualSync: volsync.InitialSyncTriggerString,
+								LastSyncTime:   &metav1.Time{Time: time.Now()},
+							}
+							Expect(k8sClient.Status().Update(ctx, createdRS)).To(Succeed())
+
+							Eventually(func() *volsyncv1alpha1.ReplicationSourceTriggerSpec {
+								_, _, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+
+								return createdRS.Spec.Trigger
+							}, maxWait, interval).Should(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
+								Schedule: &expectedCronSpecSchedule,
+							}))
+						})
+					})
+
+					Context("When the trigger mode is manual", func() {
+						JustBeforeEach(func() {
+							vsHandler.SetTriggerMode(ramendrv1alpha1.VolSyncTriggerModeManual)
+						})
+
+						It("Should create the RS with no schedule, and sync only when triggered", func() {
+							_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(returnedRS).NotTo(BeNil())
+
+							createdRS := &volsyncv1alpha1.ReplicationSource{}
+							Eventually(func() error {
+								return k8sClient.Get(ctx, client.ObjectKeyFromObject(returnedRS), createdRS)
+							}, maxWait, interval).Should(Succeed())
+							Expect(createdRS.Spec.Trigger).To(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
+								Manual: volsync.InitialSyncTriggerString,
+							}))
+
+							Expect(vsHandler.TriggerManualSync(rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace,
+								"external-sync-1")).To(Succeed())
+
+							// Reconciling keeps the manual trigger, with no schedule
+							Eventually(func() *volsyncv1alpha1.ReplicationSourceTriggerSpec {
+								_, _, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+
+								return createdRS.Spec.Trigger
+							}, maxWait, interval).Should(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
+								Manual: "external-sync-1",
+							}))
+						})
+
+						It("Should not allow a manual sync when the trigger mode is scheduled", func() {
+							_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(returnedRS).NotTo(BeNil())
+
+							vsHandler.SetTriggerMode(ramendrv1alpha1.VolSyncTriggerModeScheduled)
+
+							Expect(vsHandler.TriggerManualSync(rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace,
+								"external-sync-1")).To(MatchError(volsync.ErrTriggerModeNotManual))
+						})
+					})
+
+					Context("When the sync of the RS fails", func() {
+						BeforeEach(func() {
+							vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+								false, &ramendrv1alpha1.VolSyncConfig{MoverFailureLogLines: 2})
+						})
+
+						It("Should report the failing mover pod, and keep it once VolSync reports the failure", func() {
+							_, returnedRS, err := vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(returnedRS).NotTo(BeNil())
+
+							failure, err := vsHandler.MoverFailure(returnedRS, nil)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(failure).To(BeNil())
+
+							// Fake out a mover pod in a crash loop
+							moverPod := &corev1.Pod{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "volsync-rsync-tls-src-" + returnedRS.GetName() + "-abcde",
+									Namespace: testNamespace.GetName(),
+									Labels:    map[string]string{"job-name": "volsync-rsync-tls-src-" + returnedRS.GetName()},
+								},
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Name: "rsync-tls", Image: "testimage123"}},
+								},
+							}
+							Expect(k8sClient.Create(ctx, moverPod)).To(Succeed())
+							moverPod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+								Name: "rsync-tls",
+								State: corev1.ContainerState{
+									Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+								},
+								LastTerminationState: corev1.ContainerState{
+									Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+								},
+							}}
+							Expect(k8sClient.Status().Update(ctx, moverPod)).To(Succeed())
+
+							Eventually(func() *ramendrv1alpha1.VolSyncMoverFailure {
+								failure, err = vsHandler.MoverFailure(returnedRS, nil)
+								Expect(err).ToNot(HaveOccurred())
+
+								return failure
+							}, maxWait, interval).Should(Equal(&ramendrv1alpha1.VolSyncMoverFailure{
+								MoverPod: moverPod.GetName(),
+								Reason:   "CrashLoopBackOff (last exit code 1)",
+							}))
+
+							// Fake out VolSync recording the failure and deleting the mover pod
+							Expect(k8sClient.Delete(ctx, moverPod)).To(Succeed())
+							Eventually(func() bool {
+								return kerrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(moverPod), moverPod))
+							}, maxWait, interval).Should(BeTrue())
+
+							returnedRS.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+								LatestMoverStatus: &volsyncv1alpha1.MoverStatus{
+									Result: volsyncv1alpha1.MoverResultFailed,
+									Logs:   "connecting\nconnection refused\nrsync error: exit code 10\n",
+								},
+							}
+
+							failure, err = vsHandler.MoverFailure(returnedRS, failure)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(failure).To(Equal(&ramendrv1alpha1.VolSyncMoverFailure{
+								MoverPod: moverPod.GetName(),
+								Reason:   "CrashLoopBackOff (last exit code 1)",
+								Logs:     "connection refused\nrsync error: exit code 10",
+							}))
+
+							// A successful sync clears the failure
+							returnedRS.Status.LatestMoverStatus.Result = volsyncv1alpha1.MoverResultSuccessful
+
+							failure, err = vsHandler.MoverFailure(returnedRS, failure)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(failure).To(BeNil())
+						})
+					})
+
+					Context("When reconciling RS with no previous RD", func() {
+						var returnedRS *volsyncv1alpha1.ReplicationSource
+
+						JustBeforeEach(func() {
+							finalSyncDone := false
+							var err error
+
+							// Run ReconcileRS - Not running final sync so this should return false
+							finalSyncDone, returnedRS, err = vsHandler.ReconcileRS(rsSpec, false)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(finalSyncDone).To(BeFalse())
+
+							// RS should be created with name=PVCName and owner is our vrg
+							Eventually(func() bool {
+								err := k8sClient.Get(ctx,
+									types.NamespacedName{
+										Name:      rsSpec.ProtectedPVC.Name,
+										Namespace: testNamespace.GetName(),
+									},
+									createdRS)
+								if err != nil {
+									return false
+								}
+
+								return ownerMatches(createdRS, owner.GetName(), "ConfigMap",
+									true /* Should be controller */)
+							}, maxWait, interval).Should(BeTrue())
+
+							// Check that the volsync psk secret has been updated to have our vrg as owner
+							Eventually(func() bool {
+								err := k8sClient.Get(ctx, client.ObjectKeyFromObject(dummyPSKSecret), dummyPSKSecret)
+								if err != nil {
+									return false
+								}
+
+								// The psk secret should be updated to be owned by the VRG
+								return ownerMatches(dummyPSKSecret, owner.GetName(), "ConfigMap", false)
+							}, maxWait, interval).Should(BeTrue())
+
+							// Check common fields
+							Expect(createdRS.Spec.SourcePVC).To(Equal(rsSpec.ProtectedPVC.Name))
+							Expect(createdRS.Spec.RsyncTLS).NotTo(BeNil())
+							Expect(createdRS.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
+							// Note owner here is faking out a VRG - psk secret name will be based on the owner (VRG) name
+							Expect(*createdRS.Spec.RsyncTLS.KeySecret).To(Equal(volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())))
+							Expect(*createdRS.Spec.RsyncTLS.Address).To(Equal("volsync-rsync-tls-dst-" +
+								rsSpec.ProtectedPVC.Name + "." + testNamespace.GetName() + ".svc.clusterset.local"))
+							Expect(volsync.GetReplicationSourceAddress(createdRS)).To(Equal(*createdRS.Spec.RsyncTLS.Address))
+
+							Expect(*createdRS.Spec.RsyncTLS.VolumeSnapshotClassName).To(Equal(testVolumeSnapshotClassName))
+
+							Expect(createdRS.Spec.Trigger).ToNot(BeNil())
+							Expect(createdRS.Spec.Trigger).To(Equal(&volsyncv1alpha1.ReplicationSourceTriggerSpec{
+								Schedule: &expectedCronSpecSchedule,
+							}))
+							Expect(createdRS.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, owner.GetName()))
+							Expect(createdRS.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
+						})
+
+						It("Should create an ReplicationSource if one does not exist", func() {
+							// All checks here performed in the JustBeforeEach(common checks)
+							Expect(returnedRS).NotTo(BeNil())
+						})
+
+						Context("When the RS is reconciled again unchanged", func() {
+							It("Should not update the RS", func() {
+								resourceVersion := createdRS.GetResourceVersion()
+
+								_, rs, err := vsHandler.ReconcileRS(rsSpec, false)
+								Expect(err).ToNot(HaveOccurred())
+								Expect(rs.GetResourceVersion()).To(Equal(resourceVersion))
+
+								Expect(vsHandler.ReconcileSummary().Updated).To(BeZero())
+								Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+								Expect(createdRS.GetResourceVersion()).To(Equal(resourceVersion))
+							})
+						})
+
+						Context("When a field ramen leaves unset is set on the RS", func() {
+							It("Should clear the field when reconciled again", func() {
+								moverServiceAccount := "custom-mover-sa"
+								createdRS.Spec.RsyncTLS.MoverServiceAccount = &moverServiceAccount
+								Expect(k8sClient.Update(ctx, createdRS)).To(Succeed())
+
+								Eventually(func(g Gomega) {
+									_, rs, err := vsHandler.ReconcileRS(rsSpec, false)
+									g.Expect(err).ToNot(HaveOccurred())
+									g.Expect(rs.Spec.RsyncTLS.MoverServiceAccount).To(BeNil())
+
+									g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(createdRS), createdRS)).To(Succeed())
+									g.Expect(createdRS.Spec.RsyncTLS.MoverServiceAccount).To(BeNil())
+								}, maxWait, interval).Should(Succeed())
+								Expect(vsHandler.ReconcileSummary().Updated).To(BeNumerically(">", 0))
+							})
+						})
+
+						Context("When replication annotations are configured", func() {
+							BeforeEach(func() {
+								vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+									false, &ramendrv1alpha1.VolSyncConfig{ReplicationAnnotations: map[string]string{
+										"example.com/cost-center":       "1234",
+										"ramendr.openshift.io/reserved": "ignored",
+									}})
+							})
+
+							It("Should add the replication annotations, except those reserved for Ramen", func() {
+								// Ramen managed fields are checked in the JustBeforeEach(common checks)
+								Expect(createdRS.GetAnnotations()).To(HaveKeyWithValue("example.com/cost-center", "1234"))
+								Expect(createdRS.GetAnnotations()).NotTo(HaveKey("ramendr.openshift.io/reserved"))
+							})
+						})
+
+						Context("When replication source already exists", func() {
+							var rsPrecreate *volsyncv1alpha1.ReplicationSource
+
+							BeforeEach(func() {
+								// Pre-create a replication destination - and fill out Status.Address
 								rsPrecreate = &volsyncv1alpha1.ReplicationSource{
 									ObjectMeta: metav1.ObjectMeta{
 										Name:      rsSpec.ProtectedPVC.Name,
@@ -1092,6 +2344,35 @@ var _ = Describe("VolSync_Handler", func() {
 											Expect(finalSyncDone).To(BeTrue())
 											Expect(returnedRS).NotTo(BeNil())
 										})
+
+										It("Should report the final sync timed out if the mover does not complete it in time", func() {
+											finalSyncDone, returnedRS, err := vsHandler.ReconcileRS(rsSpec, true)
+											Expect(err).ToNot(HaveOccurred())
+											Expect(finalSyncDone).To(BeFalse())
+											Expect(returnedRS).NotTo(BeNil())
+
+											// Simulate a mover stuck syncing for longer than the final sync timeout
+											startTime := metav1.NewTime(time.Now().Add(-volsync.DefaultFinalSyncTimeout - time.Minute))
+											returnedRS.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+												LastSyncStartTime: &startTime,
+												LatestMoverStatus: &volsyncv1alpha1.MoverStatus{
+													Result: volsyncv1alpha1.MoverResultFailed,
+												},
+											}
+											Expect(k8sClient.Status().Update(ctx, returnedRS)).To(Succeed())
+
+											Eventually(func() bool {
+												err := k8sClient.Get(ctx, client.ObjectKeyFromObject(returnedRS), returnedRS)
+
+												return err == nil && returnedRS.Status != nil && returnedRS.Status.LastSyncStartTime != nil
+											}, maxWait, interval).Should(BeTrue())
+
+											finalSyncDone, returnedRS, err = vsHandler.ReconcileRS(rsSpec, true)
+											Expect(err).To(MatchError(volsync.ErrFinalSyncTimedOut))
+											Expect(err.Error()).To(ContainSubstring(string(volsyncv1alpha1.MoverResultFailed)))
+											Expect(finalSyncDone).To(BeFalse())
+											Expect(returnedRS).NotTo(BeNil())
+										})
 									})
 								})
 							})
@@ -1099,109 +2380,2336 @@ var _ = Describe("VolSync_Handler", func() {
 					})
 				})
 			})
-		})
-	})
+		})
+	})
+
+	Describe("Ensure VolSync secret ownership", func() {
+		Context("When the secret does not exist", func() {
+			It("Should report the secret is not found", func() {
+				secretExists, err := vsHandler.EnsureSecretOwnership()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretExists).To(BeFalse())
+			})
+		})
+
+		Context("When the secret exists", func() {
+			var secret *corev1.Secret
+
+			BeforeEach(func() {
+				secret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName()),
+						Namespace: testNamespace.GetName(),
+					},
+				}
+				Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+				Eventually(func() error {
+					return k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+				}, maxWait, interval).Should(Succeed())
+			})
+
+			It("Should add the owner reference on the secret", func() {
+				secretExists, err := vsHandler.EnsureSecretOwnership()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretExists).To(BeTrue())
+
+				Eventually(func() bool {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					return ownerMatches(secret, owner.GetName(), "ConfigMap", false)
+				}, maxWait, interval).Should(BeTrue())
+			})
+
+			Context("When a psk secret owner is configured", func() {
+				var secretOwner *corev1.ConfigMap
+
+				BeforeEach(func() {
+					secretOwner = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "psk-secret-manager", Namespace: testNamespace.GetName()},
+					}
+					Expect(k8sClient.Create(ctx, secretOwner)).To(Succeed())
+
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+						&ramendrv1alpha1.VolSyncConfig{PSKSecretOwner: &ramendrv1alpha1.VolSyncSecretOwner{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       secretOwner.GetName(),
+						}})
+				})
+
+				It("Should add the reference of the configured owner, rather than the VRG, on the secret", func() {
+					Eventually(func() error {
+						_, err := vsHandler.EnsureSecretOwnership()
+
+						return err
+					}, maxWait, interval).Should(Succeed())
+
+					Eventually(func() bool {
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+						return ownerMatches(secret, secretOwner.GetName(), "ConfigMap", false)
+					}, maxWait, interval).Should(BeTrue())
+					Expect(ownerMatches(secret, owner.GetName(), "ConfigMap", false)).To(BeFalse())
+				})
+			})
+
+			Context("When the configured psk secret owner does not exist", func() {
+				BeforeEach(func() {
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+						&ramendrv1alpha1.VolSyncConfig{PSKSecretOwner: &ramendrv1alpha1.VolSyncSecretOwner{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       "missing-psk-secret-manager",
+						}})
+				})
+
+				It("Should fail to ensure the secret ownership", func() {
+					_, err := vsHandler.EnsureSecretOwnership()
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("missing-psk-secret-manager"))
+				})
+			})
+		})
+	})
+
+	Describe("Release VolSync secret", func() {
+		var secret *corev1.Secret
+		var ownerRefs []metav1.OwnerReference
+
+		ownerRefFor := func(obj metav1.Object) metav1.OwnerReference {
+			return metav1.OwnerReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       obj.GetName(),
+				UID:        obj.GetUID(),
+			}
+		}
+
+		JustBeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName()),
+					Namespace:       testNamespace.GetName(),
+					OwnerReferences: ownerRefs,
+				},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+			}, maxWait, interval).Should(Succeed())
+
+			Expect(vsHandler.ReleaseVolSyncSecret()).To(Succeed())
+		})
+
+		Context("When the secret is also owned by another owner", func() {
+			var otherOwnerCm *corev1.ConfigMap
+
+			BeforeEach(func() {
+				otherOwnerCm = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "other-cm-owner-",
+						Namespace:    testNamespace.GetName(),
+					},
+				}
+				Expect(k8sClient.Create(ctx, otherOwnerCm)).To(Succeed())
+
+				ownerRefs = []metav1.OwnerReference{ownerRefFor(owner), ownerRefFor(otherOwnerCm)}
+			})
+
+			It("Should remove only the owner reference of the released owner", func() {
+				Eventually(func() []metav1.OwnerReference {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					return secret.GetOwnerReferences()
+				}, maxWait, interval).Should(Equal([]metav1.OwnerReference{ownerRefFor(otherOwnerCm)}))
+			})
+		})
+
+		Context("When the secret is owned only by the released owner", func() {
+			BeforeEach(func() {
+				ownerRefs = []metav1.OwnerReference{ownerRefFor(owner)}
+			})
+
+			It("Should keep the owner reference so the secret is garbage collected with the owner", func() {
+				Consistently(func() []metav1.OwnerReference {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					return secret.GetOwnerReferences()
+				}, 1*time.Second, interval).Should(Equal([]metav1.OwnerReference{ownerRefFor(owner)}))
+			})
+		})
+
+		Context("When the secret is held by a finalizer", func() {
+			BeforeEach(func() {
+				ownerRefs = []metav1.OwnerReference{ownerRefFor(owner)}
+			})
+
+			JustBeforeEach(func() {
+				// Release again once the finalizer is added, then let the secret go
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+				secret.SetFinalizers([]string{"example.com/secret-protection"})
+				Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+				Eventually(func() []string {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					return secret.GetFinalizers()
+				}, maxWait, interval).ShouldNot(BeEmpty())
+
+				Expect(vsHandler.ReleaseVolSyncSecret()).To(Succeed())
+
+				DeferCleanup(func() {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+					secret.SetFinalizers(nil)
+					Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+				})
+			})
+
+			It("Should keep the owner reference so the secret is garbage collected with the owner", func() {
+				Consistently(func() []metav1.OwnerReference {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					return secret.GetOwnerReferences()
+				}, 1*time.Second, interval).Should(Equal([]metav1.OwnerReference{ownerRefFor(owner)}))
+			})
+		})
+
+		Context("When the secret is used by a ReplicationSource of another owner", func() {
+			var rs *volsyncv1alpha1.ReplicationSource
+
+			BeforeEach(func() {
+				ownerRefs = []metav1.OwnerReference{ownerRefFor(owner)}
+
+				secretName := volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())
+				rs = &volsyncv1alpha1.ReplicationSource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rs-other-owner-secret-user",
+						Namespace: testNamespace.GetName(),
+						Labels: map[string]string{
+							volsync.VRGOwnerNameLabel:      "other-owner",
+							volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+						},
+					},
+					Spec: volsyncv1alpha1.ReplicationSourceSpec{
+						SourcePVC: "other-owner-pvc",
+						RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
+							KeySecret: &secretName,
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+				Eventually(func() error {
+					return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+				}, maxWait, interval).Should(Succeed())
+			})
+
+			It("Should hand the owner reference over to the ReplicationSource of the other owner", func() {
+				Eventually(func() []types.UID {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+					uids := []types.UID{}
+					for _, ownerRef := range secret.GetOwnerReferences() {
+						uids = append(uids, ownerRef.UID)
+					}
+
+					return uids
+				}, maxWait, interval).Should(Equal([]types.UID{rs.GetUID()}))
+			})
+		})
+	})
+
+	Describe("Apply schedule to all ReplicationSources", func() {
+		var rsNames []string
+
+		finalSyncRSName := "rs-schedule-finalsync"
+		initialSyncRSName := "rs-schedule-initialsync"
+
+		createRS := func(name string, trigger *volsyncv1alpha1.ReplicationSourceTriggerSpec) {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: name,
+					Trigger:   trigger,
+				},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			rsNames = []string{}
+
+			for i := 0; i < 3; i++ {
+				rsName := "rs-schedule-" + strconv.Itoa(i)
+				createRS(rsName, &volsyncv1alpha1.ReplicationSourceTriggerSpec{Schedule: &expectedCronSpecSchedule})
+				rsNames = append(rsNames, rsName)
+			}
+
+			createRS(finalSyncRSName, &volsyncv1alpha1.ReplicationSourceTriggerSpec{Manual: "final-sync"})
+			createRS(initialSyncRSName, &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+				Manual: volsync.InitialSyncTriggerString,
+			})
+		})
+
+		It("Should update the schedule of every owned ReplicationSource not holding a manual trigger", func() {
+			converged, skipped, err := vsHandler.ApplyScheduleToAll("10m")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converged).To(HaveLen(len(rsNames)))
+			Expect(skipped).To(ConsistOf(testNamespace.GetName()+"/"+finalSyncRSName,
+				testNamespace.GetName()+"/"+initialSyncRSName))
+
+			for _, rsName := range rsNames {
+				Expect(converged).To(ContainElement(testNamespace.GetName() + "/" + rsName))
+
+				rs := &volsyncv1alpha1.ReplicationSource{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: rsName, Namespace: testNamespace.GetName()},
+					rs)).To(Succeed())
+				Expect(rs.Spec.Trigger).NotTo(BeNil())
+				Expect(rs.Spec.Trigger.Schedule).NotTo(BeNil())
+				Expect(*rs.Spec.Trigger.Schedule).To(Equal("*/10 * * * *"))
+			}
+
+			finalSyncRS := &volsyncv1alpha1.ReplicationSource{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: finalSyncRSName, Namespace: testNamespace.GetName()},
+				finalSyncRS)).To(Succeed())
+			Expect(finalSyncRS.Spec.Trigger.Schedule).To(BeNil())
+			Expect(finalSyncRS.Spec.Trigger.Manual).To(Equal("final-sync"))
+		})
+
+		It("Should fail for an invalid scheduling interval", func() {
+			_, _, err := vsHandler.ApplyScheduleToAll("10x")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Effective schedule of a PVC", func() {
+		pvcName := "rs-effective-schedule"
+
+		BeforeEach(func() {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pvcName,
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: pvcName,
+					Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+						Schedule: &expectedCronSpecSchedule,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+			}, maxWait, interval).Should(Succeed())
+		})
+
+		expectEffectiveSchedule := func(schedulingInterval, cronSpec string) {
+			_, _, err := vsHandler.ApplyScheduleToAll(schedulingInterval)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() (string, error) {
+				return vsHandler.EffectiveSchedule(pvcName, testNamespace.GetName())
+			}, maxWait, interval).Should(Equal(cronSpec))
+		}
+
+		It("Should return the cronspec of a minutes interval", func() {
+			expectEffectiveSchedule("10m", "*/10 * * * *")
+		})
+
+		It("Should return the cronspec of an hours interval", func() {
+			expectEffectiveSchedule("2h", "0 */2 * * *")
+		})
+
+		It("Should return the cronspec of a days interval", func() {
+			expectEffectiveSchedule("3d", "0 0 */3 * *")
+		})
+
+		It("Should return an empty cronspec for a PVC without a ReplicationSource", func() {
+			cronSpec, err := vsHandler.EffectiveSchedule("no-such-pvc", testNamespace.GetName())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronSpec).To(BeEmpty())
+		})
+	})
+
+	Describe("Last sync stats of a PVC", func() {
+		pvcName := "rs-sync-stats"
+		lastSyncTime := metav1.NewTime(time.Now().Truncate(time.Second))
+
+		var rs *volsyncv1alpha1.ReplicationSource
+
+		BeforeEach(func() {
+			rs = &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pvcName,
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: pvcName,
+				},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+			}, maxWait, interval).Should(Succeed())
+		})
+
+		statusUpdate := func(moverStatus *volsyncv1alpha1.MoverStatus) {
+			rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+				LastSyncTime:      &lastSyncTime,
+				LastSyncDuration:  &metav1.Duration{Duration: 2 * time.Minute},
+				LatestMoverStatus: moverStatus,
+			}
+			Expect(k8sClient.Status().Update(ctx, rs)).To(Succeed())
+		}
+
+		It("Should return the transfer stats logged by the mover", func() {
+			statusUpdate(&volsyncv1alpha1.MoverStatus{
+				Result: volsyncv1alpha1.MoverResultSuccessful,
+				Logs: "sent 1,234,567 bytes  received 433 bytes  10,291.67 bytes/sec\n" +
+					"total size is 5,000,000  speedup is 4.05\nrsync completed in 120s",
+			})
+
+			Eventually(func() (volsync.SyncStats, error) {
+				return vsHandler.LastSyncStats(pvcName, testNamespace.GetName())
+			}, maxWait, interval).Should(Equal(volsync.SyncStats{
+				Available:        true,
+				LastSyncTime:     &lastSyncTime,
+				Duration:         2 * time.Minute,
+				BytesTransferred: 1235000,
+				BytesPerSecond:   10291.67,
+			}))
+		})
+
+		It("Should return not available stats if the mover did not log them", func() {
+			statusUpdate(nil)
+
+			Eventually(func() (volsync.SyncStats, error) {
+				return vsHandler.LastSyncStats(pvcName, testNamespace.GetName())
+			}, maxWait, interval).Should(Equal(volsync.SyncStats{
+				LastSyncTime: &lastSyncTime,
+				Duration:     2 * time.Minute,
+			}))
+		})
+
+		It("Should return not available stats if no sync completed", func() {
+			stats, err := vsHandler.LastSyncStats(pvcName, testNamespace.GetName())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.Available).To(BeFalse())
+			Expect(stats.LastSyncTime).To(BeNil())
+		})
+
+		It("Should return not available stats for a PVC without a ReplicationSource", func() {
+			stats, err := vsHandler.LastSyncStats("no-such-pvc", testNamespace.GetName())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.Available).To(BeFalse())
+		})
+	})
+
+	Describe("Recovery of a missing destination PVC", func() {
+		var rd *volsyncv1alpha1.ReplicationDestination
+		var moverJob *batchv1.Job
+
+		BeforeEach(func() {
+			rd = &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dst-pvc",
+					Namespace: testNamespace.GetName(),
+				},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+			moverJob = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "volsync-rsync-tls-dst-" + rd.GetName(),
+					Namespace: testNamespace.GetName(),
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "rsync-tls", Image: "mover"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, moverJob)).To(Succeed())
+		})
+
+		latestImageSet := func() {
+			apiGrp := APIGrp
+			rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+				LatestImage: &corev1.TypedLocalObjectReference{
+					Kind:     volsync.VolumeSnapshotKind,
+					APIGroup: &apiGrp,
+					Name:     "dst-pvc-snap",
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+		}
+
+		It("Should not restart the mover of an RD that has not synced yet", func() {
+			Expect(vsHandler.RecoverMissingDestinationPVC(rd)).To(BeFalse())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(moverJob), moverJob)).To(Succeed())
+		})
+
+		It("Should not restart the mover of an RD whose destination PVC exists", func() {
+			latestImageSet()
+			Expect(k8sClient.Create(ctx, &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "volsync-" + rd.GetName() + "-dst",
+					Namespace: testNamespace.GetName(),
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			})).To(Succeed())
+
+			Eventually(func() (bool, error) {
+				return vsHandler.RecoverMissingDestinationPVC(rd)
+			}, maxWait, interval).Should(BeFalse())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(moverJob), moverJob)).To(Succeed())
+		})
+
+		It("Should restart the mover of an active RD whose destination PVC was deleted", func() {
+			latestImageSet()
+			Expect(vsHandler.RecoverMissingDestinationPVC(rd)).To(BeTrue())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(moverJob), moverJob)
+
+				return kerrors.IsNotFound(err) || !moverJob.GetDeletionTimestamp().IsZero()
+			}, maxWait, interval).Should(BeTrue())
+
+			By("reporting the PVC missing again if the mover job is already gone")
+			Expect(vsHandler.RecoverMissingDestinationPVC(rd)).To(BeTrue())
+		})
+	})
+
+	Describe("Workloads holding a PVC", func() {
+		var pvc *corev1.PersistentVolumeClaim
+		var deployment *appsv1.Deployment
+		var statefulSet *appsv1.StatefulSet
+
+		labels := map[string]string{"app": "quiesce"}
+		podTemplate := corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "c1", Image: "testimage123"}}},
+		}
+		replicas := int32(2)
+		isController := true
+
+		podControlledBy := func(owner client.Object, kind string) {
+			pod := createDummyPodMountingPVC(pvc, corev1.PodRunning, true)
+			pod.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       kind,
+				Name:       owner.GetName(),
+				UID:        owner.GetUID(),
+				Controller: &isController,
+			}}
+			Expect(k8sClient.Update(ctx, pod)).To(Succeed())
+			Eventually(func() []metav1.OwnerReference {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+
+				return pod.GetOwnerReferences()
+			}, maxWait, interval).Should(HaveLen(1))
+		}
+
+		BeforeEach(func() {
+			pvc = createDummyPVC("quiesce-pvc", testNamespace.GetName(), resource.MustParse("1Gi"), nil)
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "quiesce-deployment", Namespace: testNamespace.GetName()},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: &replicas,
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: podTemplate,
+				},
+			}
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			replicaSet := &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      deployment.GetName() + "-5d8f6",
+					Namespace: testNamespace.GetName(),
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+						Kind:       "Deployment",
+						Name:       deployment.GetName(),
+						UID:        deployment.GetUID(),
+						Controller: &isController,
+					}},
+				},
+				Spec: appsv1.ReplicaSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: podTemplate,
+				},
+			}
+			Expect(k8sClient.Create(ctx, replicaSet)).To(Succeed())
+
+			statefulSet = &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "quiesce-statefulset", Namespace: testNamespace.GetName()},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: &replicas,
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: podTemplate,
+				},
+			}
+			Expect(k8sClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(replicaSet), replicaSet)
+			}, maxWait, interval).Should(Succeed())
+
+			podControlledBy(replicaSet, "ReplicaSet")
+			podControlledBy(statefulSet, "StatefulSet")
+		})
+
+		workloadReplicas := func(obj client.Object) func() *int32 {
+			return func() *int32 {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+
+				switch workload := obj.(type) {
+				case *appsv1.Deployment:
+					return workload.Spec.Replicas
+				case *appsv1.StatefulSet:
+					return workload.Spec.Replicas
+				}
+
+				return nil
+			}
+		}
+
+		It("Should identify the Deployment and StatefulSet whose pods use the PVC", func() {
+			Expect(vsHandler.PVCWorkloads(client.ObjectKeyFromObject(pvc))).To(Equal([]volsync.PVCWorkload{
+				{Kind: "Deployment", Name: deployment.GetName()},
+				{Kind: "StatefulSet", Name: statefulSet.GetName()},
+			}))
+		})
+
+		It("Should only report the workloads unless quiescing is enabled", func() {
+			Expect(vsHandler.QuiescePVCWorkloads(client.ObjectKeyFromObject(pvc))).To(HaveLen(2))
+			Consistently(workloadReplicas(deployment), "1s", interval).Should(HaveValue(Equal(replicas)))
+		})
+
+		It("Should scale down the workloads, recording their replicas, if quiescing is enabled", func() {
+			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+				&ramendrv1alpha1.VolSyncConfig{FinalSyncQuiesceWorkloads: true})
+
+			Expect(vsHandler.QuiescePVCWorkloads(client.ObjectKeyFromObject(pvc))).To(HaveLen(2))
+
+			for _, workload := range []client.Object{deployment, statefulSet} {
+				Eventually(workloadReplicas(workload), maxWait, interval).Should(HaveValue(BeZero()))
+				Expect(workload.GetAnnotations()).To(HaveKeyWithValue(volsync.QuiescedReplicasAnnotation, "2"))
+			}
+		})
+
+		It("Should scale the quiesced workloads back up once the final sync is no longer run", func() {
+			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+				&ramendrv1alpha1.VolSyncConfig{FinalSyncQuiesceWorkloads: true})
+
+			Expect(vsHandler.QuiescePVCWorkloads(client.ObjectKeyFromObject(pvc))).To(HaveLen(2))
+			Eventually(workloadReplicas(deployment), maxWait, interval).Should(HaveValue(BeZero()))
+			Eventually(workloadReplicas(statefulSet), maxWait, interval).Should(HaveValue(BeZero()))
+
+			Expect(vsHandler.RestoreQuiescedWorkloads([]string{testNamespace.GetName()})).To(Succeed())
+
+			for _, workload := range []client.Object{deployment, statefulSet} {
+				Eventually(workloadReplicas(workload), maxWait, interval).Should(HaveValue(Equal(replicas)))
+				Expect(workload.GetAnnotations()).NotTo(HaveKey(volsync.QuiescedReplicasAnnotation))
+				Expect(workload.GetAnnotations()).NotTo(HaveKey(volsync.QuiescedByAnnotation))
+			}
+		})
+	})
+
+	Describe("Estimate initial sync of a PVC", func() {
+		throughput := resource.MustParse("1Mi")
+		protectedPVC := ramendrv1alpha1.ProtectedPVC{
+			Name: "new-large-pvc",
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Mi")},
+			},
+		}
+
+		BeforeEach(func() {
+			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+				&ramendrv1alpha1.VolSyncConfig{InitialSyncThroughput: &throughput})
+		})
+
+		It("Should assume the configured throughput if no prior sync reported its throughput", func() {
+			Expect(vsHandler.EstimateInitialSync(protectedPVC)).To(Equal(volsync.InitialSyncEstimate{
+				Duration:       10 * time.Second,
+				BytesPerSecond: float64(throughput.Value()),
+			}))
+		})
+
+		It("Should use the throughput observed by prior syncs", func() {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "synced-pvc",
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: "synced-pvc"},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+				LastSyncTime: &metav1.Time{Time: time.Now()},
+				LatestMoverStatus: &volsyncv1alpha1.MoverStatus{
+					Result: volsyncv1alpha1.MoverResultSuccessful,
+					Logs:   "sent 4,194,304 bytes  received 433 bytes  2,097,152.00 bytes/sec",
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, rs)).To(Succeed())
+
+			Eventually(func() (volsync.InitialSyncEstimate, error) {
+				return vsHandler.EstimateInitialSync(protectedPVC)
+			}, maxWait, interval).Should(Equal(volsync.InitialSyncEstimate{
+				Duration:       5 * time.Second,
+				BytesPerSecond: 2097152,
+				FromHistory:    true,
+			}))
+		})
+	})
+
+	Describe("Snapshot import", func() {
+		pvcName := "import-pvc"
+
+		var importVSHandler *volsync.VSHandler
+		var protectedPVC ramendrv1alpha1.ProtectedPVC
+
+		BeforeEach(func() {
+			importVSHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+				&ramendrv1alpha1.VolSyncConfig{SnapshotImportEnabled: true})
+			protectedPVC = ramendrv1alpha1.ProtectedPVC{
+				Name:               pvcName,
+				Namespace:          testNamespace.GetName(),
+				ProtectedByVolSync: true,
+				StorageClassName:   &testStorageClassName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			}
+		})
+
+		Context("When snapshot import is disabled", func() {
+			It("Should not snapshot the PVC", func() {
+				snapshotImport, err := vsHandler.EnsureImportSnapshot(
+					ramendrv1alpha1.VolSyncReplicationSourceSpec{ProtectedPVC: protectedPVC})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshotImport).To(BeNil())
+
+				snapList := &snapv1.VolumeSnapshotList{}
+				Expect(k8sClient.List(ctx, snapList, client.InNamespace(testNamespace.GetName()))).To(Succeed())
+				Expect(snapList.Items).To(BeEmpty())
+			})
+		})
+
+		Context("When snapshot import is enabled on the primary", func() {
+			It("Should record the handle of the snapshot of the PVC once it is ready", func() {
+				rsSpec := ramendrv1alpha1.VolSyncReplicationSourceSpec{ProtectedPVC: protectedPVC}
+
+				snapshotImport, err := importVSHandler.EnsureImportSnapshot(rsSpec)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshotImport).To(BeNil())
+
+				snapshot := &snapv1.VolumeSnapshot{}
+				Eventually(func() int {
+					snapList := &snapv1.VolumeSnapshotList{}
+					Expect(k8sClient.List(ctx, snapList, client.InNamespace(testNamespace.GetName()),
+						client.MatchingLabels{volsync.ImportSnapshotPVCLabel: pvcName})).To(Succeed())
+					if len(snapList.Items) == 1 {
+						snapList.Items[0].DeepCopyInto(snapshot)
+					}
+
+					return len(snapList.Items)
+				}, maxWait, interval).Should(Equal(1))
+				Expect(*snapshot.Spec.Source.PersistentVolumeClaimName).To(Equal(pvcName))
+
+				// Bind the snapshot to a content, as the snapshot controller would
+				snapshotHandle := "backend-snapshot-handle"
+				content := &snapv1.VolumeSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{Name: "import-content-" + testNamespace.GetName()},
+					Spec: snapv1.VolumeSnapshotContentSpec{
+						DeletionPolicy: snapv1.VolumeSnapshotContentDelete,
+						Driver:         testCephFSStorageDriverName,
+						Source:         snapv1.VolumeSnapshotContentSource{SnapshotHandle: &snapshotHandle},
+						VolumeSnapshotRef: corev1.ObjectReference{
+							Name:      snapshot.GetName(),
+							Namespace: snapshot.GetNamespace(),
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, content)).To(Succeed())
+				content.Status = &snapv1.VolumeSnapshotContentStatus{SnapshotHandle: &snapshotHandle}
+				Expect(k8sClient.Status().Update(ctx, content)).To(Succeed())
+
+				ready := true
+				snapshot.Status = &snapv1.VolumeSnapshotStatus{
+					BoundVolumeSnapshotContentName: &content.Name,
+					ReadyToUse:                     &ready,
+				}
+				Expect(k8sClient.Status().Update(ctx, snapshot)).To(Succeed())
+
+				Eventually(func() (*ramendrv1alpha1.VolSyncSnapshotImport, error) {
+					return importVSHandler.EnsureImportSnapshot(rsSpec)
+				}, maxWait, interval).Should(Equal(&ramendrv1alpha1.VolSyncSnapshotImport{
+					SnapshotHandle:          snapshotHandle,
+					Driver:                  testCephFSStorageDriverName,
+					VolumeSnapshotClassName: *snapshot.Spec.VolumeSnapshotClassName,
+				}))
+			})
+		})
+
+		Context("When snapshot import is enabled on the peer", func() {
+			It("Should import the recorded snapshot to restore the PVC from", func() {
+				protectedPVC.SnapshotImport = &ramendrv1alpha1.VolSyncSnapshotImport{
+					SnapshotHandle: "peer-snapshot-handle",
+					Driver:         testCephFSStorageDriverName,
+				}
+
+				err := importVSHandler.EnsurePVCfromRD(
+					ramendrv1alpha1.VolSyncReplicationDestinationSpec{ProtectedPVC: protectedPVC}, true)
+				Expect(err).To(MatchError(volsync.ErrSnapshotNotReady))
+
+				snapList := &snapv1.VolumeSnapshotList{}
+				Expect(k8sClient.List(ctx, snapList, client.InNamespace(testNamespace.GetName()))).To(Succeed())
+				Expect(snapList.Items).To(HaveLen(1))
+
+				snapshot := snapList.Items[0]
+				Expect(snapshot.Spec.Source.VolumeSnapshotContentName).NotTo(BeNil())
+
+				content := &snapv1.VolumeSnapshotContent{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: *snapshot.Spec.Source.VolumeSnapshotContentName},
+					content)).To(Succeed())
+				DeferCleanup(k8sClient.Delete, ctx, content)
+				Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentRetain))
+				Expect(*content.Spec.Source.SnapshotHandle).To(Equal("peer-snapshot-handle"))
+				Expect(content.Spec.VolumeSnapshotRef.Name).To(Equal(snapshot.GetName()))
+				Expect(content.Spec.VolumeSnapshotRef.Namespace).To(Equal(testNamespace.GetName()))
+			})
+		})
+	})
+
+	Describe("Detect drift", func() {
+		createRS := func(name, schedule string) {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: name,
+					Trigger:   &volsyncv1alpha1.ReplicationSourceTriggerSpec{Schedule: &schedule},
+					RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
+						ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+							CopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+			}, maxWait, interval).Should(Succeed())
+		}
+
+		It("Should report no drift for ReplicationSources as Ramen sets them", func() {
+			createRS("rs-no-drift", expectedCronSpecSchedule)
+
+			reports, err := vsHandler.DetectDrift(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports).To(BeEmpty())
+		})
+
+		It("Should report the drifted schedule of a ReplicationSource", func() {
+			createRS("rs-no-drift", expectedCronSpecSchedule)
+			createRS("rs-drift", "0 0 * * *")
+
+			reports, err := vsHandler.DetectDrift(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports).To(Equal([]volsync.DriftReport{{
+				Kind:      "ReplicationSource",
+				Name:      "rs-drift",
+				Namespace: testNamespace.GetName(),
+				Fields: []volsync.FieldDrift{{
+					Field:   volsync.DriftFieldSchedule,
+					Desired: expectedCronSpecSchedule,
+					Actual:  "0 0 * * *",
+				}},
+			}}))
+		})
+	})
+
+	Describe("Get PVC protection status", func() {
+		ownerLabels := func() map[string]string {
+			return map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+			}
+		}
+		ownerKey := func() types.NamespacedName {
+			return types.NamespacedName{Name: owner.GetName(), Namespace: owner.GetNamespace()}
+		}
+		pvcKey := func(name string) types.NamespacedName {
+			return types.NamespacedName{Name: name, Namespace: testNamespace.GetName()}
+		}
+
+		It("Should report a PVC with a synced ReplicationSource as protected by its VRG", func() {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "status-rs-pvc",
+					Namespace: testNamespace.GetName(),
+					Labels:    ownerLabels(),
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: "status-rs-pvc"},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{LastSyncTime: &metav1.Time{Time: time.Now()}}
+			Expect(k8sClient.Status().Update(ctx, rs)).To(Succeed())
+
+			Eventually(func() bool {
+				status, err := volsync.GetPVCProtectionStatus(ctx, k8sClient, logger, pvcKey("status-rs-pvc"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Protected).To(BeTrue())
+				Expect(status.Primary).To(BeTrue())
+				Expect(status.VRG).To(Equal(ownerKey()))
+
+				return status.DataProtected
+			}, maxWait, interval).Should(BeTrue())
+		})
+
+		It("Should report a PVC with a ReplicationDestination and its latest image", func() {
+			rd := &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "status-rd-pvc",
+					Namespace: testNamespace.GetName(),
+					Labels:    ownerLabels(),
+				},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+			status, err := volsync.GetPVCProtectionStatus(ctx, k8sClient, logger, pvcKey("status-rd-pvc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Protected).To(BeTrue())
+			Expect(status.Primary).To(BeFalse())
+			Expect(status.VRG).To(Equal(ownerKey()))
+			Expect(status.DataProtected).To(BeFalse())
+
+			apiGrp := APIGrp
+			latestImage := &corev1.TypedLocalObjectReference{
+				Kind:     volsync.VolumeSnapshotKind,
+				APIGroup: &apiGrp,
+				Name:     "status-rd-snap",
+			}
+			rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{LatestImage: latestImage}
+			Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+
+			Eventually(func() bool {
+				status, err = volsync.GetPVCProtectionStatus(ctx, k8sClient, logger, pvcKey("status-rd-pvc"))
+				Expect(err).NotTo(HaveOccurred())
+
+				return status.DataProtected
+			}, maxWait, interval).Should(BeTrue())
+			Expect(status.LatestImage).To(Equal(latestImage))
+		})
+
+		It("Should report a PVC without a ReplicationSource or ReplicationDestination as not protected", func() {
+			status, err := volsync.GetPVCProtectionStatus(ctx, k8sClient, logger, pvcKey("status-no-pvc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(volsync.PVCProtectionStatus{}))
+		})
+	})
+
+	Describe("Replication conditions of protected PVCs", func() {
+		ownerLabels := func() map[string]string {
+			return map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+			}
+		}
+
+		createRS := func(name string, status *volsyncv1alpha1.ReplicationSourceStatus) {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace.GetName(), Labels: ownerLabels()},
+				Spec:       volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: name},
+			}
+			Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+			if status != nil {
+				rs.Status = status
+				Expect(k8sClient.Status().Update(ctx, rs)).To(Succeed())
+			}
+		}
+
+		It("Should report one condition per PVC reflecting its ReplicationSource or ReplicationDestination", func() {
+			createRS("conditions-synced-pvc", &volsyncv1alpha1.ReplicationSourceStatus{
+				LastSyncTime: &metav1.Time{Time: time.Now()},
+			})
+			createRS("conditions-failed-pvc", &volsyncv1alpha1.ReplicationSourceStatus{
+				LastSyncTime:      &metav1.Time{Time: time.Now()},
+				LatestMoverStatus: &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultFailed},
+			})
+			createRS("conditions-initial-pvc", nil)
+
+			rd := &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conditions-rd-pvc",
+					Namespace: testNamespace.GetName(),
+					Labels:    ownerLabels(),
+				},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+			address := "1.2.3.4"
+			rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{Address: &address},
+			}
+			Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+
+			protectedPVCs := []ramendrv1alpha1.ProtectedPVC{}
+			for _, pvcName := range []string{
+				"conditions-synced-pvc", "conditions-failed-pvc", "conditions-initial-pvc", "conditions-rd-pvc",
+				"conditions-missing-pvc",
+			} {
+				protectedPVCs = append(protectedPVCs,
+					ramendrv1alpha1.ProtectedPVC{Name: pvcName, Namespace: testNamespace.GetName()})
+			}
+
+			reasons := func() []string {
+				conditions, err := vsHandler.ReplicationConditions(protectedPVCs, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(conditions).To(HaveLen(len(protectedPVCs)))
+
+				reasons := []string{}
+				for i, pvcCondition := range conditions {
+					Expect(pvcCondition.Name).To(Equal(protectedPVCs[i].Name))
+					Expect(pvcCondition.Condition.Type).To(Equal(volsync.ConditionTypeReplicationReady))
+					Expect(pvcCondition.Condition.ObservedGeneration).To(Equal(int64(3)))
+					reasons = append(reasons, pvcCondition.Condition.Reason)
+				}
+
+				return reasons
+			}
+
+			Eventually(reasons, maxWait, interval).Should(Equal([]string{
+				volsync.ConditionReasonReplicationReady,
+				volsync.ConditionReasonReplicationSyncFailed,
+				volsync.ConditionReasonReplicationProgressing,
+				volsync.ConditionReasonReplicationReady,
+				volsync.ConditionReasonReplicationProgressing,
+			}))
+		})
+	})
+
+	Describe("Migrate owner labels", func() {
+		oldNameLabel := "old-vrg-owner"
+		oldNamespaceLabel := "old-vrg-owner-namespace"
+
+		oldLabelsMeta := func(name string, owned bool) metav1.ObjectMeta {
+			objectMeta := metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace.GetName(),
+				Labels: map[string]string{
+					oldNameLabel:      owner.GetName(),
+					oldNamespaceLabel: owner.GetNamespace(),
+					"other":           "label",
+				},
+			}
+
+			if owned {
+				objectMeta.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       owner.GetName(),
+					UID:        owner.GetUID(),
+				}}
+			}
+
+			return objectMeta
+		}
+
+		var ownedRS, unownedRS *volsyncv1alpha1.ReplicationSource
+		var ownedRD *volsyncv1alpha1.ReplicationDestination
+
+		BeforeEach(func() {
+			ownedRS = &volsyncv1alpha1.ReplicationSource{ObjectMeta: oldLabelsMeta("migrate-owned-rs", true)}
+			unownedRS = &volsyncv1alpha1.ReplicationSource{ObjectMeta: oldLabelsMeta("migrate-unowned-rs", false)}
+			ownedRD = &volsyncv1alpha1.ReplicationDestination{ObjectMeta: oldLabelsMeta("migrate-owned-rd", true)}
+
+			for _, obj := range []client.Object{ownedRS, unownedRS, ownedRD} {
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			Eventually(func() int {
+				rsList := &volsyncv1alpha1.ReplicationSourceList{}
+				Expect(k8sClient.List(ctx, rsList, client.InNamespace(testNamespace.GetName()),
+					client.MatchingLabels{oldNameLabel: owner.GetName()})).To(Succeed())
+
+				rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+				Expect(k8sClient.List(ctx, rdList, client.InNamespace(testNamespace.GetName()),
+					client.MatchingLabels{oldNameLabel: owner.GetName()})).To(Succeed())
+
+				return len(rsList.Items) + len(rdList.Items)
+			}, maxWait, interval).Should(Equal(3))
+		})
+
+		It("Should relabel only the objects with an owner reference to the VRG", func() {
+			migrated, err := vsHandler.MigrateOwnerLabels(oldNameLabel, oldNamespaceLabel)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(2))
+
+			newLabels := map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+				"other":                        "label",
+			}
+
+			Eventually(func() map[string]string {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(ownedRS), ownedRS)).To(Succeed())
+
+				return ownedRS.GetLabels()
+			}, maxWait, interval).Should(Equal(newLabels))
+
+			Eventually(func() map[string]string {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(ownedRD), ownedRD)).To(Succeed())
+
+				return ownedRD.GetLabels()
+			}, maxWait, interval).Should(Equal(newLabels))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(unownedRS), unownedRS)).To(Succeed())
+			Expect(unownedRS.GetLabels()).To(HaveKeyWithValue(oldNameLabel, owner.GetName()))
+			Expect(unownedRS.GetLabels()).NotTo(HaveKey(volsync.VRGOwnerNameLabel))
+		})
+
+		It("Should find nothing left to relabel when run again", func() {
+			_, err := vsHandler.MigrateOwnerLabels(oldNameLabel, oldNamespaceLabel)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() int {
+				migrated, err := vsHandler.MigrateOwnerLabels(oldNameLabel, oldNamespaceLabel)
+				Expect(err).NotTo(HaveOccurred())
+
+				return migrated
+			}, maxWait, interval).Should(Equal(0))
+		})
+	})
+
+	Describe("Migrate stale owner namespace", func() {
+		var otherNamespace *corev1.Namespace
+
+		staleMeta := func(name, namespace, ownerNamespace string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					volsync.VRGOwnerNameLabel:      owner.GetName(),
+					volsync.VRGOwnerNamespaceLabel: ownerNamespace,
+				},
+			}
+		}
+
+		var migratableRS, unmigratableRS, otherOwnerRS *volsyncv1alpha1.ReplicationSource
+		var migratableRD *volsyncv1alpha1.ReplicationDestination
+
+		BeforeEach(func() {
+			otherNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "vh-stale-"}}
+			Expect(k8sClient.Create(ctx, otherNamespace)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, otherNamespace)
+
+			// The VRG of the same name still exists in the other namespace for otherOwnerRS
+			otherOwner := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: owner.GetName(), Namespace: otherNamespace.GetName()},
+			}
+			Expect(k8sClient.Create(ctx, otherOwner)).To(Succeed())
+
+			migratableRS = &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: staleMeta("stale-rs", testNamespace.GetName(), "removed-namespace"),
+			}
+			migratableRD = &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: staleMeta("stale-rd", testNamespace.GetName(), "removed-namespace"),
+			}
+			unmigratableRS = &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: staleMeta("stale-rs-other-namespace", otherNamespace.GetName(), "removed-namespace"),
+			}
+			otherOwnerRS = &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: staleMeta("other-owner-rs", otherNamespace.GetName(), otherNamespace.GetName()),
+			}
+
+			for _, obj := range []client.Object{migratableRS, migratableRD, unmigratableRS, otherOwnerRS} {
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(otherOwner), otherOwner); err != nil {
+					return err
+				}
+
+				for _, obj := range []client.Object{migratableRS, migratableRD, unmigratableRS, otherOwnerRS} {
+					if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			}, maxWait, interval).Should(Succeed())
+		})
+
+		It("Should migrate the objects the VRG can own and flag the others", func() {
+			migrated, stale, err := vsHandler.MigrateStaleOwnerNamespace()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated).To(Equal(2))
+			Expect(stale).To(ConsistOf(
+				fmt.Sprintf("ReplicationSource %s/%s", otherNamespace.GetName(), unmigratableRS.GetName())))
+
+			for _, obj := range []client.Object{migratableRS, migratableRD} {
+				Eventually(func() map[string]string {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+
+					return obj.GetLabels()
+				}, maxWait, interval).Should(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
+
+				Expect(obj.GetOwnerReferences()).To(ContainElement(HaveField("UID", owner.GetUID())))
+			}
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(otherOwnerRS), otherOwnerRS)).To(Succeed())
+			Expect(otherOwnerRS.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel,
+				otherNamespace.GetName()))
+		})
+
+		Context("When the VRG is in an admin namespace", func() {
+			BeforeEach(func() {
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", true,
+					nil)
+			})
+
+			It("Should migrate the objects in any namespace", func() {
+				migrated, stale, err := vsHandler.MigrateStaleOwnerNamespace()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(migrated).To(Equal(3))
+				Expect(stale).To(BeEmpty())
+
+				Eventually(func() map[string]string {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(unmigratableRS), unmigratableRS)).To(Succeed())
+
+					return unmigratableRS.GetLabels()
+				}, maxWait, interval).Should(HaveKeyWithValue(volsync.VRGOwnerNamespaceLabel, owner.GetNamespace()))
+				Expect(unmigratableRS.GetOwnerReferences()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Validate mover RBAC", func() {
+		setNamespaceMeta := func(annotations, labels map[string]string) {
+			ns := &corev1.Namespace{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(testNamespace), ns)).To(Succeed())
+
+			for key, value := range annotations {
+				Expect(util.AddAnnotation(ns, key, value)).To(BeTrue())
+			}
+
+			for key, value := range labels {
+				Expect(util.AddLabel(ns, key, value)).To(BeTrue())
+			}
+
+			Expect(k8sClient.Update(ctx, ns)).To(Succeed())
+		}
+
+		Context("When the namespace is not annotated for privileged movers", func() {
+			It("Should succeed", func() {
+				Expect(vsHandler.ValidateMoverRBAC(testNamespace.GetName())).To(Succeed())
+			})
+		})
+
+		Context("When the service account configured for movers does not exist", func() {
+			It("Should report the missing service account", func() {
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+					&ramendrv1alpha1.VolSyncConfig{MoverServiceAccountName: "missing-mover-sa"})
+
+				Expect(vsHandler.ValidateMoverRBAC(testNamespace.GetName())).To(
+					MatchError(volsync.ErrMoverServiceAccountMissing))
+			})
+		})
+
+		Context("When the namespace is annotated for privileged movers", func() {
+			privilegedMovers := map[string]string{volsync.PrivilegedMoversAnnotation: "true"}
+
+			Context("When the namespace enforces the restricted pod security level", func() {
+				It("Should report the missing permissions with remediation", func() {
+					setNamespaceMeta(privilegedMovers, map[string]string{volsync.PodSecurityEnforceLabel: "restricted"})
+
+					Eventually(func() error {
+						return vsHandler.ValidateMoverRBAC(testNamespace.GetName())
+					}, maxWait, interval).Should(MatchError(volsync.ErrVolSyncRBACMissing))
+
+					err := vsHandler.ValidateMoverRBAC(testNamespace.GetName())
+					Expect(err.Error()).To(ContainSubstring(volsync.PodSecurityEnforceLabel + "=privileged"))
+				})
+			})
+
+			Context("When the namespace enforces the privileged pod security level", func() {
+				It("Should succeed", func() {
+					setNamespaceMeta(privilegedMovers, map[string]string{volsync.PodSecurityEnforceLabel: "privileged"})
+
+					Eventually(func() error {
+						ns := &corev1.Namespace{}
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(testNamespace), ns)).To(Succeed())
+
+						if ns.GetAnnotations()[volsync.PrivilegedMoversAnnotation] != "true" {
+							return fmt.Errorf("namespace not yet annotated")
+						}
+
+						return nil
+					}, maxWait, interval).Should(Succeed())
+
+					// No SecurityContextConstraints CRD is installed, as on a non OpenShift cluster
+					Expect(vsHandler.ValidateMoverRBAC(testNamespace.GetName())).To(Succeed())
+				})
+			})
+		})
+	})
+
+	Describe("Teardown VolSync", func() {
+		rsPVCName := "teardown-rs-pvc"
+		rdPVCName := "teardown-rd-pvc"
+		snapshotName := "teardown-snap"
+
+		ownerLabels := func() map[string]string {
+			return map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+			}
+		}
+
+		BeforeEach(func() {
+			createDummyPVC(rsPVCName, testNamespace.GetName(), resource.MustParse("1Gi"), nil)
+
+			taken, err := vsHandler.TakePVCOwnership(types.NamespacedName{
+				Name:      rsPVCName,
+				Namespace: testNamespace.GetName(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeTrue())
+
+			Expect(k8sClient.Create(ctx, &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      rsPVCName,
+					Namespace: testNamespace.GetName(),
+					Labels:    ownerLabels(),
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: rsPVCName},
+			})).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      rdPVCName,
+					Namespace: testNamespace.GetName(),
+					Labels:    ownerLabels(),
+				},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+				},
+			})).To(Succeed())
+
+			snapshot := createSnapshot(snapshotName, testNamespace.GetName())
+			snapshot.Labels = ownerLabels()
+			snapshot.Labels[volsync.VolSyncDoNotDeleteLabel] = volsync.VolSyncDoNotDeleteLabelVal
+			Expect(k8sClient.Update(ctx, snapshot)).To(Succeed())
+
+			Eventually(func() int {
+				snapList := &snapv1.VolumeSnapshotList{}
+				Expect(k8sClient.List(ctx, snapList, client.InNamespace(testNamespace.GetName()),
+					client.MatchingLabels(ownerLabels()))).To(Succeed())
+
+				return len(snapList.Items)
+			}, maxWait, interval).Should(Equal(1))
+		})
+
+		It("Should remove all owned VolSync artifacts and leave the protected PVC to the user", func() {
+			Eventually(vsHandler.TeardownVolSync, maxWait, interval).Should(Succeed())
+
+			Expect(kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+				Name: rsPVCName, Namespace: testNamespace.GetName(),
+			}, &volsyncv1alpha1.ReplicationSource{}))).To(BeTrue())
+			Expect(kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+				Name: rdPVCName, Namespace: testNamespace.GetName(),
+			}, &volsyncv1alpha1.ReplicationDestination{}))).To(BeTrue())
+			Expect(kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+				Name: snapshotName, Namespace: testNamespace.GetName(),
+			}, &snapv1.VolumeSnapshot{}))).To(BeTrue())
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name: rsPVCName, Namespace: testNamespace.GetName(),
+				}, pvc)
+				if err != nil {
+					return false
+				}
+
+				_, annotated := pvc.GetAnnotations()[volsync.ACMAppSubDoNotDeleteAnnotation]
+
+				return len(pvc.GetOwnerReferences()) == 0 && !annotated
+			}, maxWait, interval).Should(BeTrue())
+			Expect(pvc.GetDeletionTimestamp()).To(BeNil())
+		})
+
+		Context("When the snapshot has a recorded retention", func() {
+			var retainUntil time.Time
+
+			JustBeforeEach(func() {
+				snapshot := &snapv1.VolumeSnapshot{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{
+					Name: snapshotName, Namespace: testNamespace.GetName(),
+				}, snapshot)).To(Succeed())
+
+				snapshot.Annotations = map[string]string{volsync.RetainUntilAnnotation: retainUntil.Format(time.RFC3339)}
+				Expect(k8sClient.Update(ctx, snapshot)).To(Succeed())
+
+				Eventually(func() map[string]string {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapshot), snapshot)).To(Succeed())
+
+					return snapshot.GetAnnotations()
+				}, maxWait, interval).Should(HaveKey(volsync.RetainUntilAnnotation))
+			})
+
+			Context("When the retention has not expired", func() {
+				BeforeEach(func() {
+					retainUntil = time.Now().Add(time.Hour)
+				})
+
+				It("Should not release the snapshot", func() {
+					Expect(vsHandler.TeardownVolSync()).To(MatchError(volsync.ErrSnapshotRetained))
+
+					snapshot := &snapv1.VolumeSnapshot{}
+					Consistently(func() map[string]string {
+						Expect(k8sClient.Get(ctx, types.NamespacedName{
+							Name: snapshotName, Namespace: testNamespace.GetName(),
+						}, snapshot)).To(Succeed())
+
+						return snapshot.GetLabels()
+					}, 1*time.Second, interval).Should(
+						HaveKeyWithValue(volsync.VolSyncDoNotDeleteLabel, volsync.VolSyncDoNotDeleteLabelVal))
+				})
+			})
+
+			Context("When the retention has expired", func() {
+				BeforeEach(func() {
+					retainUntil = time.Now().Add(-time.Hour)
+				})
+
+				It("Should release and delete the snapshot", func() {
+					Eventually(vsHandler.TeardownVolSync, maxWait, interval).Should(Succeed())
+
+					Expect(kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+						Name: snapshotName, Namespace: testNamespace.GetName(),
+					}, &snapv1.VolumeSnapshot{}))).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("List PVCs with both a ReplicationSource and a ReplicationDestination", func() {
+		ownerLabels := func() map[string]string {
+			return map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+			}
+		}
+		createRS := func(name string) {
+			Expect(k8sClient.Create(ctx, &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace.GetName(), Labels: ownerLabels()},
+				Spec:       volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: name},
+			})).To(Succeed())
+		}
+		createRD := func(name string) {
+			Expect(k8sClient.Create(ctx, &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace.GetName(), Labels: ownerLabels()},
+			})).To(Succeed())
+		}
+
+		It("Should report no PVC when each has either a ReplicationSource or a ReplicationDestination", func() {
+			createRS("pvc-source")
+			createRD("pvc-destination")
+
+			Consistently(func() ([]types.NamespacedName, error) {
+				return vsHandler.ListPVCsWithRSAndRD()
+			}, "2s", interval).Should(BeEmpty())
+		})
+
+		It("Should report the PVCs that have both a ReplicationSource and a ReplicationDestination", func() {
+			createRS("pvc-source")
+			createRS("pvc-both")
+			createRD("pvc-both")
+			// Local RS and RD created for the Direct copy method
+			createRD("pvc-direct")
+			createRD("pvc-direct-local")
+			createRS("pvc-direct-local")
+
+			Eventually(func() ([]types.NamespacedName, error) {
+				return vsHandler.ListPVCsWithRSAndRD()
+			}, maxWait, interval).Should(ConsistOf(
+				types.NamespacedName{Name: "pvc-both", Namespace: testNamespace.GetName()}))
+		})
+	})
+
+	Describe("Total bytes protected by the ReplicationDestinations", func() {
+		createRD := func(name, capacity string, labels map[string]string) *volsyncv1alpha1.ReplicationDestination {
+			rdLabels := map[string]string{
+				volsync.VRGOwnerNameLabel:      owner.GetName(),
+				volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+			}
+			for key, value := range labels {
+				rdLabels[key] = value
+			}
+
+			capacityQuantity := resource.MustParse(capacity)
+			rd := &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace.GetName(), Labels: rdLabels},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
+						ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+							Capacity: &capacityQuantity,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+			return rd
+		}
+
+		BeforeEach(func() {
+			createRD("protected-pvc-a", "1Gi", nil)
+			createRD("protected-pvc-b", "2Gi", nil)
+			// Local RD kept for a failover, not counted
+			createRD("protected-pvc-b-local", "2Gi",
+				map[string]string{volsync.VolSyncDoNotDeleteLabel: volsync.VolSyncDoNotDeleteLabelVal})
+		})
+
+		It("Should sum the capacity of the ReplicationDestinations by default", func() {
+			Eventually(vsHandler.TotalProtectedBytes, maxWait, interval).Should(Equal(int64(3 * 1024 * 1024 * 1024)))
+		})
+
+		Context("When the data used is accounted", func() {
+			BeforeEach(func() {
+				vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+					&ramendrv1alpha1.VolSyncConfig{ProtectedBytesAccounting: volsync.ProtectedBytesAccountingUsed})
+
+				rd := createRD("protected-pvc-c", "4Gi", nil)
+
+				latestImageSnap := createSnapshot("protected-pvc-c-snap", testNamespace.GetName())
+				restoreSize := resource.MustParse("1Mi")
+				latestImageSnap.Status.RestoreSize = &restoreSize
+				Expect(k8sClient.Status().Update(ctx, latestImageSnap)).To(Succeed())
+
+				apiGrp := APIGrp
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+					LatestImage: &corev1.TypedLocalObjectReference{
+						Kind:     volsync.VolumeSnapshotKind,
+						APIGroup: &apiGrp,
+						Name:     latestImageSnap.GetName(),
+					},
+				}
+				Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+			})
+
+			It("Should sum the restore size of the latest images, or the capacity if there is no latest image", func() {
+				Eventually(vsHandler.TotalProtectedBytes, maxWait, interval).Should(
+					Equal(int64(3*1024*1024*1024 + 1024*1024)))
+			})
+		})
+	})
+
+	Describe("Replication wiring report", func() {
+		pvcName := "wired-pvc"
+
+		var pskSecretName string
+
+		linksHealth := func(source bool) func() (map[volsync.ReplicationLink]bool, error) {
+			return func() (map[volsync.ReplicationLink]bool, error) {
+				report, err := vsHandler.ValidateReplicationWiring(pvcName, testNamespace.GetName(), source)
+				if err != nil {
+					return nil, err
+				}
+
+				Expect(report.PVC).To(Equal(types.NamespacedName{Name: pvcName, Namespace: testNamespace.GetName()}))
+
+				health := map[volsync.ReplicationLink]bool{}
+				for _, link := range report.Links {
+					health[link.Link] = link.Healthy
+				}
+
+				return health, nil
+			}
+		}
+
+		BeforeEach(func() {
+			pskSecretName = volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName())
+		})
+
+		It("Should report every link as broken if nothing is wired", func() {
+			Expect(linksHealth(false)()).To(Equal(map[volsync.ReplicationLink]bool{
+				volsync.ReplicationLinkSecret:                 false,
+				volsync.ReplicationLinkReplicationDestination: false,
+				volsync.ReplicationLinkServiceExport:          false,
+			}))
+			Expect(linksHealth(true)()).To(Equal(map[volsync.ReplicationLink]bool{
+				volsync.ReplicationLinkSecret:            false,
+				volsync.ReplicationLinkReplicationSource: false,
+			}))
+		})
+
+		Context("When the psk secret is propagated", func() {
+			BeforeEach(func() {
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: pskSecretName, Namespace: testNamespace.GetName()},
+				}
+				Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+				Eventually(func() error {
+					return k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+				}, maxWait, interval).Should(Succeed())
+			})
+
+			It("Should report the secret as broken until it is owned", func() {
+				Expect(linksHealth(false)()).To(HaveKeyWithValue(volsync.ReplicationLinkSecret, false))
+
+				Expect(vsHandler.EnsureSecretOwnership()).To(BeTrue())
+
+				Eventually(linksHealth(false), maxWait, interval).Should(
+					HaveKeyWithValue(volsync.ReplicationLinkSecret, true))
+			})
+
+			Context("When the psk secret is owned", func() {
+				BeforeEach(func() {
+					Expect(vsHandler.EnsureSecretOwnership()).To(BeTrue())
+					Eventually(linksHealth(false), maxWait, interval).Should(
+						HaveKeyWithValue(volsync.ReplicationLinkSecret, true))
+				})
+
+				It("Should report the destination links as broken until the RD has an address and is exported", func() {
+					rd := &volsyncv1alpha1.ReplicationDestination{
+						ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: testNamespace.GetName()},
+						Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+							RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{KeySecret: &pskSecretName},
+						},
+					}
+					Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+					Eventually(linksHealth(false), maxWait, interval).Should(Equal(map[volsync.ReplicationLink]bool{
+						volsync.ReplicationLinkSecret:                 true,
+						volsync.ReplicationLinkReplicationDestination: false,
+						volsync.ReplicationLinkServiceExport:          false,
+					}))
+
+					address := "volsync-rsync-tls-dst-" + pvcName
+					rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{Address: &address},
+					}
+					Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+
+					Eventually(linksHealth(false), maxWait, interval).Should(
+						HaveKeyWithValue(volsync.ReplicationLinkReplicationDestination, true))
+					Expect(linksHealth(false)()).To(HaveKeyWithValue(volsync.ReplicationLinkServiceExport, false))
+
+					svcExport := &unstructured.Unstructured{}
+					svcExport.SetGroupVersionKind(schema.GroupVersionKind{
+						Group:   volsync.ServiceExportGroup,
+						Kind:    volsync.ServiceExportKind,
+						Version: volsync.ServiceExportVersion,
+					})
+					svcExport.SetName(address)
+					svcExport.SetNamespace(testNamespace.GetName())
+					Expect(k8sClient.Create(ctx, svcExport)).To(Succeed())
+
+					Eventually(linksHealth(false), maxWait, interval).Should(Equal(map[volsync.ReplicationLink]bool{
+						volsync.ReplicationLinkSecret:                 true,
+						volsync.ReplicationLinkReplicationDestination: true,
+						volsync.ReplicationLinkServiceExport:          true,
+					}))
+				})
+
+				It("Should report the RS as broken unless it replicates to the exported service of the RD", func() {
+					wrongAddress := "volsync-rsync-tls-dst-" + pvcName
+					rs := &volsyncv1alpha1.ReplicationSource{
+						ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: testNamespace.GetName()},
+						Spec: volsyncv1alpha1.ReplicationSourceSpec{
+							SourcePVC: pvcName,
+							RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
+								KeySecret: &pskSecretName,
+								Address:   &wrongAddress,
+							},
+						},
+					}
+					Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+
+					Eventually(linksHealth(true), maxWait, interval).Should(Equal(map[volsync.ReplicationLink]bool{
+						volsync.ReplicationLinkSecret:            true,
+						volsync.ReplicationLinkReplicationSource: false,
+					}))
+
+					remoteAddress := volsync.GetReplicationNames(pvcName, testNamespace.GetName()).RemoteService
+					rs.Spec.RsyncTLS.Address = &remoteAddress
+					Expect(k8sClient.Update(ctx, rs)).To(Succeed())
+
+					Eventually(func() (bool, error) {
+						report, err := vsHandler.ValidateReplicationWiring(pvcName, testNamespace.GetName(), true)
+
+						return report.Healthy(), err
+					}, maxWait, interval).Should(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("Delete snapshots", func() {
+		var snapshot *snapv1.VolumeSnapshot
+		var content *snapv1.VolumeSnapshotContent
+		var deletionPolicy snapv1.DeletionPolicy
+		var volSyncConfig *ramendrv1alpha1.VolSyncConfig
+
+		JustBeforeEach(func() {
+			pvcName := "mytestpvc"
+			snapshotHandle := "testhandle"
+
+			snapshot = &snapv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "snap-",
+					Namespace:    testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      owner.GetName(),
+						volsync.VRGOwnerNamespaceLabel: owner.GetNamespace(),
+					},
+				},
+				Spec: snapv1.VolumeSnapshotSpec{
+					Source: snapv1.VolumeSnapshotSource{
+						PersistentVolumeClaimName: &pvcName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, snapshot)).To(Succeed())
+
+			content = &snapv1.VolumeSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "snapcontent-",
+				},
+				Spec: snapv1.VolumeSnapshotContentSpec{
+					DeletionPolicy: deletionPolicy,
+					Driver:         "testdriver",
+					Source: snapv1.VolumeSnapshotContentSource{
+						SnapshotHandle: &snapshotHandle,
+					},
+					VolumeSnapshotRef: corev1.ObjectReference{
+						Name:      snapshot.GetName(),
+						Namespace: snapshot.GetNamespace(),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, content)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, content)
+
+			contentName := content.GetName()
+			snapshot.Status = &snapv1.VolumeSnapshotStatus{
+				BoundVolumeSnapshotContentName: &contentName,
+			}
+			Expect(k8sClient.Status().Update(ctx, snapshot)).To(Succeed())
+
+			vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot", false,
+				volSyncConfig)
+			Expect(vsHandler.DeleteSnapshots(testNamespace.GetName())).To(Succeed())
+
+			Eventually(func() bool {
+				return kerrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapshot), snapshot))
+			}, maxWait, interval).Should(BeTrue())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(content), content)).To(Succeed())
+		})
+
+		Context("When the snapshot content deletionPolicy is Retain", func() {
+			BeforeEach(func() {
+				deletionPolicy = snapv1.VolumeSnapshotContentRetain
+			})
+
+			Context("When retained snapshot content cleanup is disabled", func() {
+				BeforeEach(func() {
+					volSyncConfig = nil
+				})
+
+				It("Should leave the snapshot content deletionPolicy as Retain", func() {
+					Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentRetain))
+				})
+			})
+
+			Context("When retained snapshot content cleanup is enabled", func() {
+				BeforeEach(func() {
+					volSyncConfig = &ramendrv1alpha1.VolSyncConfig{RetainedSnapshotContentCleanup: true}
+				})
+
+				It("Should update the snapshot content deletionPolicy to Delete", func() {
+					Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentDelete))
+				})
+			})
+		})
+
+		Context("When the snapshot content deletionPolicy is Delete", func() {
+			BeforeEach(func() {
+				deletionPolicy = snapv1.VolumeSnapshotContentDelete
+				volSyncConfig = &ramendrv1alpha1.VolSyncConfig{RetainedSnapshotContentCleanup: true}
+			})
+
+			It("Should leave the snapshot content deletionPolicy as Delete", func() {
+				Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentDelete))
+			})
+		})
+	})
+
+	Describe("Ensure PVC from ReplicationDestination", func() {
+		pvcName := "testpvc1"
+		pvcCapacity := resource.MustParse("1Gi")
+
+		var rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec
+		BeforeEach(func() {
+			rdSpec = ramendrv1alpha1.VolSyncReplicationDestinationSpec{
+				ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
+					Name:               pvcName,
+					Namespace:          testNamespace.GetName(),
+					ProtectedByVolSync: true,
+					StorageClassName:   &testStorageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: pvcCapacity,
+						},
+					},
+				},
+			}
+		})
+
+		var ensurePVCErr error
+		JustBeforeEach(func() {
+			ensurePVCErr = vsHandler.EnsurePVCfromRD(rdSpec, false)
+		})
+
+		Context("When ReplicationDestination Does not exist", func() {
+			It("Should throw an error", func() {
+				Expect(ensurePVCErr).To(HaveOccurred())
+			})
+		})
+
+		Context("When the PVC to restore is being deleted", func() {
+			BeforeEach(func() {
+				markPVCTerminating(createDummyPVC(pvcName, testNamespace.GetName(), pvcCapacity, nil))
+			})
+
+			It("Should not restore the PVC", func() {
+				Expect(ensurePVCErr).To(MatchError(volsync.ErrPVCTerminating))
+			})
+		})
+
+		Context("When ReplicationDestination exists with no latestImage", func() {
+			BeforeEach(func() {
+				// Pre-create the replication destination
+				rd := &volsyncv1alpha1.ReplicationDestination{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pvcName,
+						Namespace: testNamespace.GetName(),
+					},
+					Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+					},
+				}
+				Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+
+				// Make sure it's been created to avoid timing issues
+				Eventually(func() error {
+					return k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+				}, maxWait, interval).Should(Succeed())
+			})
+			It("Should fail to ensure PVC", func() {
+				Expect(ensurePVCErr).To(HaveOccurred())
+				Expect(ensurePVCErr.Error()).To(ContainSubstring("unable to find LatestImage"))
+			})
+		})
+
+		Context("When ReplicationDestination exists with snapshot latestImage", func() {
+			latestImageSnapshotName := "testingsnap001"
+
+			BeforeEach(func() {
+				// Pre-create the replication destination
+				rd := &volsyncv1alpha1.ReplicationDestination{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pvcName,
+						Namespace: testNamespace.GetName(),
+					},
+					Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+					},
+				}
+				Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+				apiGrp := APIGrp
+				// Now force update the status to report a volume snapshot as latestImage
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+					LatestImage: &corev1.TypedLocalObjectReference{
+						Kind:     volsync.VolumeSnapshotKind,
+						APIGroup: &apiGrp,
+						Name:     latestImageSnapshotName,
+					},
+				}
+				Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+
+				// Make sure the update is picked up by the cache before proceeding
+				Eventually(func() bool {
+					err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+					if err != nil {
+						return false
+					}
+
+					return rd.Status != nil && rd.Status.LatestImage != nil
+				}, maxWait, interval).Should(BeTrue())
+			})
+
+			Context("When the latest image volume snapshot does not exist", func() {
+				It("Should fail to ensure PVC", func() {
+					Expect(ensurePVCErr).To(HaveOccurred())
+					Expect(ensurePVCErr.Error()).To(ContainSubstring("snapshot"))
+					Expect(ensurePVCErr.Error()).To(ContainSubstring("not found"))
+					Expect(ensurePVCErr.Error()).To(ContainSubstring(latestImageSnapshotName))
+				})
+			})
+
+			Context("When the latest image volume snapshot exists but is not ready to use", func() {
+				BeforeEach(func() {
+					latestImageSnap := createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+					updateSnapshotReadyToUse(latestImageSnap, false)
+				})
+
+				It("Should fail to ensure PVC with a snapshot not ready error and not create the PVC", func() {
+					Expect(ensurePVCErr).To(HaveOccurred())
+					Expect(ensurePVCErr).To(MatchError(volsync.ErrSnapshotNotReady))
+					Expect(ensurePVCErr.Error()).To(ContainSubstring(latestImageSnapshotName))
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					Consistently(func() bool {
+						return kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+							Name:      pvcName,
+							Namespace: testNamespace.GetName(),
+						}, pvc))
+					}, 1*time.Second, interval).Should(BeTrue())
+				})
+			})
+
+			Context("When the requested size is larger than the latest image volume snapshot restore size", func() {
+				var storageClass *storagev1.StorageClass
+
+				restoreSize := resource.MustParse("1Gi")
+				requestedSize := resource.MustParse("2Gi")
+
+				createStorageClass := func(allowVolumeExpansion bool) {
+					storageClass = &storagev1.StorageClass{
+						ObjectMeta: metav1.ObjectMeta{
+							GenerateName: "testing-storage-class-expansion-",
+						},
+						Provisioner:          testStorageDriverName,
+						AllowVolumeExpansion: &allowVolumeExpansion,
+					}
+					Expect(k8sClient.Create(ctx, storageClass)).To(Succeed())
+					DeferCleanup(k8sClient.Delete, ctx, storageClass)
+
+					Eventually(func() error {
+						return k8sClient.Get(ctx, client.ObjectKeyFromObject(storageClass), storageClass)
+					}, maxWait, interval).Should(Succeed())
+
+					rdSpec.ProtectedPVC.StorageClassName = &storageClass.Name
+				}
+
+				BeforeEach(func() {
+					rdSpec.ProtectedPVC.Resources.Requests = corev1.ResourceList{
+						corev1.ResourceStorage: requestedSize,
+					}
+
+					latestImageSnap := createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+					latestImageSnap.Status.RestoreSize = &restoreSize
+					Expect(k8sClient.Status().Update(ctx, latestImageSnap)).To(Succeed())
+
+					// Make sure the update is picked up by the cache before proceeding
+					Eventually(func() bool {
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(latestImageSnap), latestImageSnap)
+						if err != nil {
+							return false
+						}
+
+						return latestImageSnap.Status != nil && latestImageSnap.Status.RestoreSize != nil
+					}, maxWait, interval).Should(BeTrue())
+				})
+
+				Context("When the storage class does not allow volume expansion", func() {
+					BeforeEach(func() {
+						createStorageClass(false)
+					})
+
+					It("Should fail to ensure PVC with a volume expansion not supported error", func() {
+						Expect(ensurePVCErr).To(MatchError(volsync.ErrVolumeExpansionNotSupported))
+						Expect(ensurePVCErr.Error()).To(ContainSubstring(storageClass.GetName()))
+
+						pvc := &corev1.PersistentVolumeClaim{}
+						Consistently(func() bool {
+							return kerrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{
+								Name:      pvcName,
+								Namespace: testNamespace.GetName(),
+							}, pvc))
+						}, 1*time.Second, interval).Should(BeTrue())
+					})
+				})
+
+				Context("When the storage class allows volume expansion", func() {
+					BeforeEach(func() {
+						createStorageClass(true)
+					})
+
+					It("Should create the PVC with the requested size", func() {
+						Expect(ensurePVCErr).NotTo(HaveOccurred())
+
+						pvc := &corev1.PersistentVolumeClaim{}
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      pvcName,
+								Namespace: testNamespace.GetName(),
+							}, pvc)
+						}, maxWait, interval).Should(Succeed())
+
+						Expect(*pvc.Spec.Resources.Requests.Storage()).To(Equal(requestedSize))
+					})
+				})
+			})
+
+			Context("When a restore capacity is annotated on the protected PVC", func() {
+				var latestImageSnap *snapv1.VolumeSnapshot
+
+				pvcSize := func() resource.Quantity {
+					pvc := &corev1.PersistentVolumeClaim{}
+					Eventually(func() error {
+						return k8sClient.Get(ctx, types.NamespacedName{
+							Name:      pvcName,
+							Namespace: testNamespace.GetName(),
+						}, pvc)
+					}, maxWait, interval).Should(Succeed())
+
+					return *pvc.Spec.Resources.Requests.Storage()
+				}
+
+				BeforeEach(func() {
+					latestImageSnap = createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+				})
+
+				Context("When the restore capacity is larger than the requested capacity", func() {
+					BeforeEach(func() {
+						rdSpec.ProtectedPVC.Annotations = map[string]string{volsync.RestoreCapacityAnnotation: "3Gi"}
+					})
+
+					It("Should create the PVC with the restore capacity", func() {
+						Expect(ensurePVCErr).NotTo(HaveOccurred())
+						Expect(pvcSize()).To(Equal(resource.MustParse("3Gi")))
+					})
+				})
+
+				Context("When the restore capacity is smaller than the snapshot restore size", func() {
+					restoreSize := resource.MustParse("2Gi")
+
+					BeforeEach(func() {
+						rdSpec.ProtectedPVC.Annotations = map[string]string{volsync.RestoreCapacityAnnotation: "1536Mi"}
+
+						latestImageSnap.Status.RestoreSize = &restoreSize
+						Expect(k8sClient.Status().Update(ctx, latestImageSnap)).To(Succeed())
+
+						Eventually(func() bool {
+							err := k8sClient.Get(ctx, client.ObjectKeyFromObject(latestImageSnap), latestImageSnap)
+							if err != nil {
+								return false
+							}
+
+							return latestImageSnap.Status != nil && latestImageSnap.Status.RestoreSize != nil
+						}, maxWait, interval).Should(BeTrue())
+					})
+
+					It("Should create the PVC with the snapshot restore size", func() {
+						Expect(ensurePVCErr).NotTo(HaveOccurred())
+						Expect(pvcSize()).To(Equal(restoreSize))
+					})
+				})
+
+				Context("When the restore capacity is smaller than the requested capacity", func() {
+					BeforeEach(func() {
+						rdSpec.ProtectedPVC.Annotations = map[string]string{volsync.RestoreCapacityAnnotation: "512Mi"}
+					})
+
+					It("Should fail to ensure PVC with an invalid restore capacity error", func() {
+						Expect(ensurePVCErr).To(MatchError(volsync.ErrInvalidRestoreCapacity))
+					})
+				})
+
+				Context("When the restore capacity cannot be parsed", func() {
+					BeforeEach(func() {
+						rdSpec.ProtectedPVC.Annotations = map[string]string{volsync.RestoreCapacityAnnotation: "large"}
+					})
+
+					It("Should fail to ensure PVC with an invalid restore capacity error", func() {
+						Expect(ensurePVCErr).To(MatchError(volsync.ErrInvalidRestoreCapacity))
+					})
+				})
+			})
+
+			Context("When labels are required on the restored PVC", func() {
+				requiredLabels := map[string]string{"app": "busybox"}
+
+				BeforeEach(func() {
+					createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+					vsHandler.SetRequiredRestoredPVCLabels(requiredLabels)
+				})
+
+				It("Should set the required labels on the restored PVC", func() {
+					Expect(ensurePVCErr).NotTo(HaveOccurred())
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					Expect(k8sClient.Get(ctx, types.NamespacedName{
+						Name:      pvcName,
+						Namespace: testNamespace.GetName(),
+					}, pvc)).To(Succeed())
+					Expect(pvc.GetLabels()).To(HaveKeyWithValue("app", "busybox"))
+				})
+
+				Context("When the PVC was already bound without the required labels", func() {
+					BeforeEach(func() {
+						apiGrp := APIGrp
+						pvc := &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      pvcName,
+								Namespace: testNamespace.GetName(),
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: pvcCapacity},
+								},
+								StorageClassName: &testStorageClassName,
+								DataSource: &corev1.TypedLocalObjectReference{
+									Name:     latestImageSnapshotName,
+									APIGroup: &apiGrp,
+									Kind:     volsync.VolumeSnapshotKind,
+								},
+							},
+						}
+						Expect(k8sClient.Create(ctx, pvc)).To(Succeed())
+
+						pvc.Status.Phase = corev1.ClaimBound
+						Expect(k8sClient.Status().Update(ctx, pvc)).To(Succeed())
+
+						Eventually(func() corev1.PersistentVolumeClaimPhase {
+							Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+
+							return pvc.Status.Phase
+						}, maxWait, interval).Should(Equal(corev1.ClaimBound))
+					})
+
+					It("Should fail to ensure PVC with a required labels missing error", func() {
+						Expect(ensurePVCErr).To(MatchError(volsync.ErrRequiredPVCLabelsMissing))
+						Expect(ensurePVCErr.Error()).To(ContainSubstring("app=busybox"))
+					})
+				})
+			})
+
+			Context("When the storage class of the protected PVC was renamed", func() {
+				BeforeEach(func() {
+					createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+
+					oldStorageClassName := "renamed-" + testStorageClassName
+					rdSpec.ProtectedPVC.StorageClassName = &oldStorageClassName
+
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+						false, &ramendrv1alpha1.VolSyncConfig{
+							StorageClassAliases: map[string]string{oldStorageClassName: testStorageClassName},
+						})
+				})
+
+				It("Should restore the PVC with the current storage class", func() {
+					Expect(ensurePVCErr).NotTo(HaveOccurred())
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					Eventually(func() error {
+						return k8sClient.Get(ctx, types.NamespacedName{
+							Name:      pvcName,
+							Namespace: testNamespace.GetName(),
+						}, pvc)
+					}, maxWait, interval).Should(Succeed())
+					Expect(pvc.Spec.StorageClassName).To(Equal(&testStorageClassName))
+				})
+			})
+
+			Context("When the protected PVC requests ReadOnlyMany", func() {
+				BeforeEach(func() {
+					createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+					rdSpec.ProtectedPVC.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
+				})
+
+				Context("When the driver does not support ReadOnlyMany restores", func() {
+					It("Should fail to ensure PVC with an access mode not supported error", func() {
+						Expect(ensurePVCErr).To(MatchError(volsync.ErrAccessModeNotSupported))
+						Expect(ensurePVCErr.Error()).To(ContainSubstring(testVolumeSnapshotClassName))
+					})
+				})
+
+				Context("When the volume snapshot class is annotated to support ReadOnlyMany restores", func() {
+					setAnnotation := func(annotations map[string]string) {
+						vsc := &snapv1.VolumeSnapshotClass{}
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(testDefaultVolumeSnapshotClass),
+							vsc)).To(Succeed())
+						vsc.SetAnnotations(annotations)
+						Expect(k8sClient.Update(ctx, vsc)).To(Succeed())
+
+						Eventually(func() map[string]string {
+							Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(vsc), vsc)).To(Succeed())
+
+							return vsc.GetAnnotations()
+						}, maxWait, interval).Should(Equal(annotations))
+					}
+
+					BeforeEach(func() {
+						original := testDefaultVolumeSnapshotClass.GetAnnotations()
+						annotations := map[string]string{
+							volsync.ReadOnlyManyRestoreAnnotation: volsync.ReadOnlyManyRestoreAnnotationVal,
+						}
+						for key, value := range original {
+							annotations[key] = value
+						}
+
+						setAnnotation(annotations)
+						DeferCleanup(setAnnotation, original)
+					})
+
+					It("Should restore the PVC ReadOnlyMany", func() {
+						Expect(ensurePVCErr).NotTo(HaveOccurred())
+					})
+				})
+
+				Context("When the driver is CephFS", func() {
+					BeforeEach(func() {
+						rdSpec.ProtectedPVC.StorageClassName = &testCephFSStorageClassName
+						vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec,
+							testCephFSStorageDriverName, "Snapshot", false, nil)
+					})
+
+					It("Should restore the PVC ReadOnlyMany", func() {
+						Expect(ensurePVCErr).NotTo(HaveOccurred())
+
+						pvc := &corev1.PersistentVolumeClaim{}
+						Eventually(func() error {
+							return k8sClient.Get(ctx, types.NamespacedName{
+								Name:      pvcName,
+								Namespace: testNamespace.GetName(),
+							}, pvc)
+						}, maxWait, interval).Should(Succeed())
+						Expect(pvc.Spec.AccessModes).To(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}))
+					})
+				})
+			})
 
-	Describe("Ensure PVC from ReplicationDestination", func() {
-		pvcName := "testpvc1"
-		pvcCapacity := resource.MustParse("1Gi")
+			Context("When VolSync updates the latest image volume snapshot concurrently", func() {
+				var conflicts int
 
-		var rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec
-		BeforeEach(func() {
-			rdSpec = ramendrv1alpha1.VolSyncReplicationDestinationSpec{
-				ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
-					Name:               pvcName,
-					Namespace:          testNamespace.GetName(),
-					ProtectedByVolSync: true,
-					StorageClassName:   &testStorageClassName,
-					Resources: corev1.VolumeResourceRequirements{
-						Requests: corev1.ResourceList{
-							corev1.ResourceStorage: pvcCapacity,
-						},
-					},
-				},
-			}
-		})
+				BeforeEach(func() {
+					createSnapshot(latestImageSnapshotName, testNamespace.GetName())
 
-		var ensurePVCErr error
-		JustBeforeEach(func() {
-			ensurePVCErr = vsHandler.EnsurePVCfromRD(rdSpec, false)
-		})
+					conflicts = 0
+					conflictingClient := &snapshotConflictingClient{Client: k8sClient, conflicts: &conflicts, limit: 2}
+					vsHandler = volsync.NewVSHandler(ctx, conflictingClient, logger, owner, asyncSpec, "none",
+						"Snapshot", false, nil)
+				})
 
-		Context("When ReplicationDestination Does not exist", func() {
-			It("Should throw an error", func() {
-				Expect(ensurePVCErr).To(HaveOccurred())
-			})
-		})
+				It("Should retry protecting the snapshot and restore the PVC", func() {
+					Expect(ensurePVCErr).NotTo(HaveOccurred())
+					Expect(conflicts).To(Equal(2))
 
-		Context("When ReplicationDestination exists with no latestImage", func() {
-			BeforeEach(func() {
-				// Pre-create the replication destination
-				rd := &volsyncv1alpha1.ReplicationDestination{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      pvcName,
+					snap := &snapv1.VolumeSnapshot{}
+					Expect(k8sClient.Get(ctx, types.NamespacedName{
+						Name:      latestImageSnapshotName,
 						Namespace: testNamespace.GetName(),
-					},
-					Spec: volsyncv1alpha1.ReplicationDestinationSpec{
-						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
-					},
-				}
-				Expect(k8sClient.Create(ctx, rd)).To(Succeed())
-
-				// Make sure it's been created to avoid timing issues
-				Eventually(func() error {
-					return k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
-				}, maxWait, interval).Should(Succeed())
-			})
-			It("Should fail to ensure PVC", func() {
-				Expect(ensurePVCErr).To(HaveOccurred())
-				Expect(ensurePVCErr.Error()).To(ContainSubstring("unable to find LatestImage"))
+					}, snap)).To(Succeed())
+					Expect(snap.GetLabels()).To(HaveKeyWithValue(volsync.VolSyncDoNotDeleteLabel,
+						volsync.VolSyncDoNotDeleteLabelVal))
+				})
 			})
-		})
 
-		Context("When ReplicationDestination exists with snapshot latestImage", func() {
-			latestImageSnapshotName := "testingsnap001"
+			Context("When restored PVCs are quarantined", func() {
+				BeforeEach(func() {
+					createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+					vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, asyncSpec, "none", "Snapshot",
+						false, &ramendrv1alpha1.VolSyncConfig{RestoredPVCMode: volsync.RestoredPVCModeQuarantine})
+				})
 
-			BeforeEach(func() {
-				// Pre-create the replication destination
-				rd := &volsyncv1alpha1.ReplicationDestination{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      pvcName,
-						Namespace: testNamespace.GetName(),
-					},
-					Spec: volsyncv1alpha1.ReplicationDestinationSpec{
-						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
-					},
-				}
-				Expect(k8sClient.Create(ctx, rd)).To(Succeed())
-				apiGrp := APIGrp
-				// Now force update the status to report a volume snapshot as latestImage
-				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
-					LatestImage: &corev1.TypedLocalObjectReference{
-						Kind:     volsync.VolumeSnapshotKind,
-						APIGroup: &apiGrp,
-						Name:     latestImageSnapshotName,
-					},
-				}
-				Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+				It("Should label the restored PVC as quarantined until it is released", func() {
+					Expect(ensurePVCErr).NotTo(HaveOccurred())
 
-				// Make sure the update is picked up by the cache before proceeding
-				Eventually(func() bool {
-					err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
-					if err != nil {
-						return false
+					pvc := &corev1.PersistentVolumeClaim{}
+					Eventually(func() error {
+						return k8sClient.Get(ctx, types.NamespacedName{
+							Name:      pvcName,
+							Namespace: testNamespace.GetName(),
+						}, pvc)
+					}, maxWait, interval).Should(Succeed())
+					Expect(pvc.GetLabels()).To(HaveKeyWithValue(volsync.QuarantineLabel, volsync.QuarantineLabelVal))
+
+					// Fake out the scanner releasing the PVC
+					pvc.Annotations = map[string]string{
+						volsync.QuarantineReleasedAnnotation: volsync.QuarantineReleasedAnnotationVal,
 					}
+					Expect(k8sClient.Update(ctx, pvc)).To(Succeed())
 
-					return rd.Status != nil && rd.Status.LatestImage != nil
-				}, maxWait, interval).Should(BeTrue())
-			})
+					Eventually(func() map[string]string {
+						Expect(vsHandler.EnsurePVCfromRD(rdSpec, false)).To(Succeed())
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
 
-			Context("When the latest image volume snapshot does not exist", func() {
-				It("Should fail to ensure PVC", func() {
-					Expect(ensurePVCErr).To(HaveOccurred())
-					Expect(ensurePVCErr.Error()).To(ContainSubstring("snapshot"))
-					Expect(ensurePVCErr.Error()).To(ContainSubstring("not found"))
-					Expect(ensurePVCErr.Error()).To(ContainSubstring(latestImageSnapshotName))
+						return pvc.GetLabels()
+					}, maxWait, interval).ShouldNot(HaveKey(volsync.QuarantineLabel))
 				})
 			})
 
@@ -1252,6 +4760,21 @@ var _ = Describe("VolSync_Handler", func() {
 					}, maxWait, interval).Should(BeTrue())
 				})
 
+				Context("When the cluster supports restoring PVCs with dataSourceRef", func() {
+					BeforeEach(func() {
+						vsHandler.SetPVCDataSourceRefSupported(true)
+					})
+
+					It("Should create the PVC with a dataSourceRef to the latest image volume snapshot", func() {
+						apiGrp := APIGrp
+						Expect(pvc.Spec.DataSourceRef).To(Equal(&corev1.TypedObjectReference{
+							Name:     latestImageSnapshotName,
+							APIGroup: &apiGrp,
+							Kind:     volsync.VolumeSnapshotKind,
+						}))
+					})
+				})
+
 				Context("When the snapshot has restoreSize specified in Gi but PVC had storage in G", func() {
 					// See: https://github.com/RamenDR/ramen/issues/578
 
@@ -1268,9 +4791,7 @@ var _ = Describe("VolSync_Handler", func() {
 						}
 
 						// Update the status on the snapshot to show a restoreSize in Gi
-						latestImageSnap.Status = &snapv1.VolumeSnapshotStatus{
-							RestoreSize: &sizeGi,
-						}
+						latestImageSnap.Status.RestoreSize = &sizeGi
 
 						Expect(k8sClient.Status().Update(ctx, latestImageSnap)).To(Succeed())
 
@@ -1291,6 +4812,40 @@ var _ = Describe("VolSync_Handler", func() {
 					})
 				})
 
+				Context("When the restored PVC requests more storage than the protected PVC", func() {
+					largerCapacity := resource.MustParse("2Gi")
+
+					BeforeEach(func() {
+						apiGrp := APIGrp
+						existingPVC := &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      pvcName,
+								Namespace: testNamespace.GetName(),
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								StorageClassName: &testStorageClassName,
+								DataSource: &corev1.TypedLocalObjectReference{
+									Name:     latestImageSnapshotName,
+									APIGroup: &apiGrp,
+									Kind:     volsync.VolumeSnapshotKind,
+								},
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: largerCapacity},
+								},
+							},
+						}
+						Expect(k8sClient.Create(ctx, existingPVC)).To(Succeed())
+						Eventually(func() error {
+							return k8sClient.Get(ctx, client.ObjectKeyFromObject(existingPVC), existingPVC)
+						}, maxWait, interval).Should(Succeed())
+					})
+
+					It("Should keep the larger storage request instead of failing to shrink the PVC", func() {
+						Expect(*pvc.Spec.Resources.Requests.Storage()).To(Equal(largerCapacity))
+					})
+				})
+
 				It("Should create PVC, latestImage VolumeSnapshot should have VRG owner ref added", func() {
 					// snapshot ownership check done in JustBeforeEach() above
 
@@ -1431,6 +4986,107 @@ var _ = Describe("VolSync_Handler", func() {
 		})
 	})
 
+	Describe("Recreate missing restored PVC", func() {
+		pvcName := "testpvc-recreate"
+		pvcCapacity := resource.MustParse("1Gi")
+		latestImageSnapshotName := "testingsnap-recreate"
+
+		var rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec
+		BeforeEach(func() {
+			rdSpec = ramendrv1alpha1.VolSyncReplicationDestinationSpec{
+				ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
+					Name:               pvcName,
+					Namespace:          testNamespace.GetName(),
+					ProtectedByVolSync: true,
+					StorageClassName:   &testStorageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: pvcCapacity,
+						},
+					},
+				},
+			}
+		})
+
+		var recreated bool
+		var recreateErr error
+		JustBeforeEach(func() {
+			recreated, recreateErr = vsHandler.RecreateMissingRestoredPVC(rdSpec)
+		})
+
+		Context("When ReplicationDestination does not exist", func() {
+			It("Should not recreate the PVC", func() {
+				Expect(recreateErr).NotTo(HaveOccurred())
+				Expect(recreated).To(BeFalse())
+			})
+		})
+
+		Context("When ReplicationDestination exists with snapshot latestImage", func() {
+			BeforeEach(func() {
+				rd := &volsyncv1alpha1.ReplicationDestination{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pvcName,
+						Namespace: testNamespace.GetName(),
+					},
+					Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+						RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+					},
+				}
+				Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+				apiGrp := APIGrp
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+					LatestImage: &corev1.TypedLocalObjectReference{
+						Kind:     volsync.VolumeSnapshotKind,
+						APIGroup: &apiGrp,
+						Name:     latestImageSnapshotName,
+					},
+				}
+				Expect(k8sClient.Status().Update(ctx, rd)).To(Succeed())
+
+				// Make sure the update is picked up by the cache before proceeding
+				Eventually(func() bool {
+					err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+					if err != nil {
+						return false
+					}
+
+					return rd.Status != nil && rd.Status.LatestImage != nil
+				}, maxWait, interval).Should(BeTrue())
+
+				createSnapshot(latestImageSnapshotName, testNamespace.GetName())
+			})
+
+			Context("When the restored PVC exists", func() {
+				BeforeEach(func() {
+					createDummyPVC(pvcName, testNamespace.GetName(), pvcCapacity, nil)
+				})
+
+				It("Should leave the PVC alone", func() {
+					Expect(recreateErr).NotTo(HaveOccurred())
+					Expect(recreated).To(BeFalse())
+				})
+			})
+
+			Context("When the restored PVC was deleted", func() {
+				It("Should recreate the PVC from the latestImage", func() {
+					Expect(recreateErr).NotTo(HaveOccurred())
+					Expect(recreated).To(BeTrue())
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					Eventually(func() error {
+						return k8sClient.Get(ctx, types.NamespacedName{
+							Name:      pvcName,
+							Namespace: testNamespace.GetName(),
+						}, pvc)
+					}, maxWait, interval).Should(Succeed())
+
+					Expect(pvc.Spec.DataSource).NotTo(BeNil())
+					Expect(pvc.Spec.DataSource.Name).To(Equal(latestImageSnapshotName))
+				})
+			})
+		})
+	})
+
 	Describe("Cleanup ReplicationDestination", func() {
 		pvcNamePrefix := "test-pvc-rdcleanuptests-"
 		pvcNamePrefixOtherOwner := "otherowner-test-pvc-rdcleanuptests-"
@@ -1472,7 +5128,7 @@ var _ = Describe("VolSync_Handler", func() {
 			Expect(k8sClient.Create(ctx, otherOwnerCm)).To(Succeed())
 			Expect(otherOwnerCm.GetName()).NotTo(BeEmpty())
 			otherVSHandler := volsync.NewVSHandler(ctx, k8sClient, logger, otherOwnerCm, asyncSpec,
-				"none", "Snapshot", false)
+				"none", "Snapshot", false, nil)
 
 			for i := 0; i < 2; i++ {
 				otherOwnerRdSpec := ramendrv1alpha1.VolSyncReplicationDestinationSpec{
@@ -1667,7 +5323,7 @@ var _ = Describe("VolSync_Handler", func() {
 			Expect(k8sClient.Create(ctx, otherOwnerCm)).To(Succeed())
 			Expect(otherOwnerCm.GetName()).NotTo(BeEmpty())
 			otherVSHandler := volsync.NewVSHandler(ctx, k8sClient, logger, otherOwnerCm, asyncSpec,
-				"none", "Snapshot", false)
+				"none", "Snapshot", false, nil)
 
 			for i := 0; i < 2; i++ {
 				otherOwnerRsSpec := ramendrv1alpha1.VolSyncReplicationSourceSpec{
@@ -1881,9 +5537,30 @@ func createSnapshot(snapshotName, namespace string) *snapv1.VolumeSnapshot {
 		return k8sClient.Get(ctx, client.ObjectKeyFromObject(volSnap), volSnap)
 	}, maxWait, interval).Should(Succeed())
 
+	updateSnapshotReadyToUse(volSnap, true)
+
 	return volSnap
 }
 
+func updateSnapshotReadyToUse(volSnap *snapv1.VolumeSnapshot, readyToUse bool) {
+	if volSnap.Status == nil {
+		volSnap.Status = &snapv1.VolumeSnapshotStatus{}
+	}
+
+	volSnap.Status.ReadyToUse = &readyToUse
+	Expect(k8sClient.Status().Update(ctx, volSnap)).To(Succeed())
+
+	// Make sure the update is picked up by the cache before proceeding
+	Eventually(func() bool {
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(volSnap), volSnap)
+		if err != nil {
+			return false
+		}
+
+		return volSnap.Status != nil && volSnap.Status.ReadyToUse != nil && *volSnap.Status.ReadyToUse == readyToUse
+	}, maxWait, interval).Should(BeTrue())
+}
+
 func createDummyPVC(pvcName, namespace string, capacity resource.Quantity,
 	annotations map[string]string,
 ) *corev1.PersistentVolumeClaim {
@@ -1917,13 +5594,52 @@ func createDummyPVC(pvcName, namespace string, capacity resource.Quantity,
 	return dummyPVC
 }
 
-//nolint:funlen
+// markPVCTerminating deletes the PVC, keeping it in Terminating with a finalizer until the test completes
+func markPVCTerminating(pvc *corev1.PersistentVolumeClaim) {
+	const finalizer = "ramendr.openshift.io/test-finalizer"
+
+	pvc.SetFinalizers(append(pvc.GetFinalizers(), finalizer))
+	Expect(k8sClient.Update(ctx, pvc)).To(Succeed())
+	Expect(k8sClient.Delete(ctx, pvc)).To(Succeed())
+
+	Eventually(func() bool {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+
+		return pvc.GetDeletionTimestamp().IsZero()
+	}, maxWait, interval).Should(BeFalse())
+
+	DeferCleanup(func() {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+		pvc.SetFinalizers(nil)
+		Expect(k8sClient.Update(ctx, pvc)).To(Succeed())
+	})
+}
+
 func createDummyPVCAndMountingPod(pvcName, namespace string, capacity resource.Quantity, annotations map[string]string,
 	desiredPodPhase corev1.PodPhase, podReady bool,
 ) (*corev1.PersistentVolumeClaim, *corev1.Pod) {
 	// Create the PVC
 	pvc := createDummyPVC(pvcName, namespace, capacity, annotations)
 
+	// A PVC mounted by a pod is bound - simulate that in the PVC status
+	pvc.Status.Phase = corev1.ClaimBound
+	Expect(k8sClient.Status().Update(ctx, pvc)).To(Succeed())
+
+	Eventually(func() corev1.PersistentVolumeClaimPhase {
+		// Make sure the pvc status update has been picked up by the cache
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+
+		return pvc.Status.Phase
+	}, maxWait, interval).Should(Equal(corev1.ClaimBound))
+
+	return pvc, createDummyPodMountingPVC(pvc, desiredPodPhase, podReady)
+}
+
+//nolint:funlen
+func createDummyPodMountingPVC(pvc *corev1.PersistentVolumeClaim, desiredPodPhase corev1.PodPhase, podReady bool,
+) *corev1.Pod {
+	namespace := pvc.GetNamespace()
+
 	// Create the pod which is mounting the pvc
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2006,7 +5722,7 @@ func createDummyPVCAndMountingPod(pvcName, namespace string, capacity resource.Q
 		}, maxWait, interval).Should(BeTrue())
 	}
 
-	return pvc, pod
+	return pod
 }
 
 func createDummyVolumeAttachmentForPVC(pvc *corev1.PersistentVolumeClaim) {
@@ -2050,3 +5766,22 @@ func cleanupNonNamespacedResources() {
 	err := k8sClient.DeleteAllOf(ctx, obj, options...)
 	Expect(client.IgnoreNotFound(err)).To(BeNil())
 }
+
+// snapshotConflictingClient fails the first limit updates of volume snapshots with a conflict, as a concurrent update
+// by VolSync would
+type snapshotConflictingClient struct {
+	client.Client
+	conflicts *int
+	limit     int
+}
+
+func (c *snapshotConflictingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if _, ok := obj.(*snapv1.VolumeSnapshot); ok && *c.conflicts < c.limit {
+		*c.conflicts++
+
+		return kerrors.NewConflict(schema.GroupResource{Group: APIGrp, Resource: "volumesnapshots"},
+			obj.GetName(), fmt.Errorf("fake concurrent update"))
+	}
+
+	return c.Client.Update(ctx, obj, opts...)
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ReplicationLink is a resource the replication of a PVC depends on
+type ReplicationLink string
+
+const (
+	ReplicationLinkSecret                 = ReplicationLink("Secret")
+	ReplicationLinkReplicationDestination = ReplicationLink("ReplicationDestination")
+	ReplicationLinkServiceExport          = ReplicationLink("ServiceExport")
+	ReplicationLinkReplicationSource      = ReplicationLink("ReplicationSource")
+)
+
+// ReplicationLinkHealth is whether a link of the replication of a PVC is wired correctly, and if not why
+type ReplicationLinkHealth struct {
+	Link    ReplicationLink
+	Healthy bool
+	Message string
+}
+
+// ReplicationWiringReport is the health of each link of the replication of a PVC on this cluster
+type ReplicationWiringReport struct {
+	PVC   types.NamespacedName
+	Links []ReplicationLinkHealth
+}
+
+// Healthy returns true if all links of the replication are wired correctly
+func (r ReplicationWiringReport) Healthy() bool {
+	for _, link := range r.Links {
+		if !link.Healthy {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *ReplicationWiringReport) add(link ReplicationLink, healthy bool, format string, args ...interface{}) {
+	r.Links = append(r.Links, ReplicationLinkHealth{
+		Link:    link,
+		Healthy: healthy,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateReplicationWiring checks, without changing any resource, the links the replication of the PVC depends on
+// on this cluster: the psk secret propagated by the hub exists and is owned, and on the source side the
+// ReplicationSource replicates to the exported service of the ReplicationDestination, or on the destination side the
+// ReplicationDestination has an address and its service is exported. Errors are returned only if a link cannot be
+// checked.
+func (v *VSHandler) ValidateReplicationWiring(pvcName, pvcNamespace string, source bool,
+) (ReplicationWiringReport, error) {
+	report := ReplicationWiringReport{PVC: types.NamespacedName{Name: pvcName, Namespace: pvcNamespace}}
+
+	if err := v.validateSecretWiring(&report); err != nil {
+		return report, err
+	}
+
+	if source {
+		return report, v.validateRSWiring(&report)
+	}
+
+	rd, err := v.validateRDWiring(&report)
+	if err != nil {
+		return report, err
+	}
+
+	return report, v.validateServiceExportWiring(&report, rd)
+}
+
+func (v *VSHandler) validateSecretWiring(report *ReplicationWiringReport) error {
+	secretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+
+	if v.volSyncConfig.PSKSecretStore == PSKSecretStoreExternal {
+		secret, err := v.getWiringSecret(secretName, report.PVC.Namespace)
+		if err != nil || secret == nil {
+			report.add(ReplicationLinkSecret, false, "secret %s/%s not synced by the external secret store",
+				report.PVC.Namespace, secretName)
+
+			return err
+		}
+
+		if len(secret.Data[PSKSecretDataKey]) == 0 {
+			report.add(ReplicationLinkSecret, false, "secret %s/%s has no %s key",
+				report.PVC.Namespace, secretName, PSKSecretDataKey)
+
+			return nil
+		}
+
+		report.add(ReplicationLinkSecret, true, "secret %s/%s synced", report.PVC.Namespace, secretName)
+
+		return nil
+	}
+
+	secret, err := v.getWiringSecret(secretName, v.owner.GetNamespace())
+	if err != nil || secret == nil {
+		report.add(ReplicationLinkSecret, false, "secret %s/%s not propagated by the hub",
+			v.owner.GetNamespace(), secretName)
+
+		return err
+	}
+
+	owner, err := v.pskSecretOwner()
+	if err != nil {
+		return err
+	}
+
+	if !secretOwnedBy(secret, owner.GetUID()) {
+		report.add(ReplicationLinkSecret, false, "secret %s/%s not owned by %s",
+			v.owner.GetNamespace(), secretName, owner.GetName())
+
+		return nil
+	}
+
+	if v.vrgInAdminNamespace {
+		copied, err := v.getWiringSecret(secretName, report.PVC.Namespace)
+		if err != nil || copied == nil {
+			report.add(ReplicationLinkSecret, false, "secret %s/%s not copied to the pvc namespace",
+				report.PVC.Namespace, secretName)
+
+			return err
+		}
+	}
+
+	report.add(ReplicationLinkSecret, true, "secret %s/%s exists and is owned by %s",
+		v.owner.GetNamespace(), secretName, owner.GetName())
+
+	return nil
+}
+
+// getWiringSecret returns the secret, or nil if it does not exist
+func (v *VSHandler) getWiringSecret(name, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting secret %s/%s (%w)", namespace, name, err)
+	}
+
+	return secret, nil
+}
+
+func secretOwnedBy(secret *corev1.Secret, ownerUID types.UID) bool {
+	for _, ownerRef := range secret.GetOwnerReferences() {
+		if ownerRef.UID == ownerUID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *VSHandler) validateRDWiring(report *ReplicationWiringReport,
+) (*volsyncv1alpha1.ReplicationDestination, error) {
+	rd, err := v.getRD(report.PVC.Name, report.PVC.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if rd == nil {
+		report.add(ReplicationLinkReplicationDestination, false, "ReplicationDestination %s not found",
+			getReplicationDestinationName(report.PVC.Name))
+
+		return nil, nil
+	}
+
+	pskSecretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+
+	switch {
+	case rd.Spec.RsyncTLS == nil || rd.Spec.RsyncTLS.KeySecret == nil || *rd.Spec.RsyncTLS.KeySecret != pskSecretName:
+		report.add(ReplicationLinkReplicationDestination, false,
+			"ReplicationDestination %s does not use secret %s", rd.GetName(), pskSecretName)
+	case !rdStatusReady(rd, v.log):
+		report.add(ReplicationLinkReplicationDestination, false,
+			"ReplicationDestination %s has no address yet", rd.GetName())
+	default:
+		report.add(ReplicationLinkReplicationDestination, true,
+			"ReplicationDestination %s listening at %s", rd.GetName(), *rd.Status.RsyncTLS.Address)
+	}
+
+	return rd, nil
+}
+
+func (v *VSHandler) validateServiceExportWiring(report *ReplicationWiringReport,
+	rd *volsyncv1alpha1.ReplicationDestination,
+) error {
+	serviceName := getLocalServiceNameForRDFromPVCName(report.PVC.Name)
+
+	if rd == nil {
+		report.add(ReplicationLinkServiceExport, false, "no ReplicationDestination to export service %s of",
+			serviceName)
+
+		return nil
+	}
+
+	svcExport := &unstructured.Unstructured{}
+	svcExport.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   ServiceExportGroup,
+		Kind:    ServiceExportKind,
+		Version: ServiceExportVersion,
+	})
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: serviceName, Namespace: rd.GetNamespace()}, svcExport)
+	if err != nil {
+		if kerrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			report.add(ReplicationLinkServiceExport, false, "ServiceExport %s not found", serviceName)
+
+			return nil
+		}
+
+		return fmt.Errorf("error getting ServiceExport %s/%s (%w)", rd.GetNamespace(), serviceName, err)
+	}
+
+	report.add(ReplicationLinkServiceExport, true, "service %s exported", serviceName)
+
+	return nil
+}
+
+func (v *VSHandler) validateRSWiring(report *ReplicationWiringReport) error {
+	rs, err := v.getRS(getReplicationSourceName(report.PVC.Name), report.PVC.Namespace)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			report.add(ReplicationLinkReplicationSource, false, "ReplicationSource %s not found",
+				getReplicationSourceName(report.PVC.Name))
+
+			return nil
+		}
+
+		return err
+	}
+
+	pskSecretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+	remoteAddress := getRemoteServiceNameForRDFromPVCName(report.PVC.Name, report.PVC.Namespace)
+
+	switch {
+	case rs.Spec.RsyncTLS == nil || rs.Spec.RsyncTLS.KeySecret == nil || *rs.Spec.RsyncTLS.KeySecret != pskSecretName:
+		report.add(ReplicationLinkReplicationSource, false,
+			"ReplicationSource %s does not use secret %s", rs.GetName(), pskSecretName)
+	case GetReplicationSourceAddress(rs) != remoteAddress:
+		report.add(ReplicationLinkReplicationSource, false,
+			"ReplicationSource %s replicates to %q instead of the exported service %s", rs.GetName(),
+			GetReplicationSourceAddress(rs), remoteAddress)
+	default:
+		report.add(ReplicationLinkReplicationSource, true,
+			"ReplicationSource %s replicates to %s", rs.GetName(), remoteAddress)
+	}
+
+	return nil
+}
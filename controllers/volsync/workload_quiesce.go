@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/controllers/util"
+)
+
+// QuiescedReplicasAnnotation records the replica count of a workload scaled down for the final sync of a PVC its
+// pods use
+const QuiescedReplicasAnnotation = "ramendr.openshift.io/quiesced-replicas"
+
+// QuiescedByAnnotation records the namespaced name of the owner that scaled down a workload for a final sync, so that
+// only that owner scales it back up
+const QuiescedByAnnotation = "ramendr.openshift.io/quiesced-by"
+
+// PVCWorkload is the controller of pods using a PVC, or the pod itself if it has no controller
+type PVCWorkload struct {
+	Kind string
+	Name string
+}
+
+func (w PVCWorkload) String() string {
+	return w.Kind + "/" + w.Name
+}
+
+// PVCWorkloads returns the controllers of the pods using the PVC, in kind and name order, resolving the ReplicaSets
+// of Deployments to the Deployments
+func (v *VSHandler) PVCWorkloads(pvcNamespacedName types.NamespacedName) ([]PVCWorkload, error) {
+	pods := &corev1.PodList{}
+
+	err := v.client.List(v.ctx, pods,
+		client.MatchingFields{util.PodVolumePVCClaimIndexName: pvcNamespacedName.Name},
+		client.InNamespace(pvcNamespacedName.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to lookup pods using pvc %s (%w)", pvcNamespacedName, err)
+	}
+
+	found := map[PVCWorkload]struct{}{}
+
+	for i := range pods.Items {
+		workload, err := v.podWorkload(&pods.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		found[workload] = struct{}{}
+	}
+
+	workloads := make([]PVCWorkload, 0, len(found))
+	for workload := range found {
+		workloads = append(workloads, workload)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].String() < workloads[j].String()
+	})
+
+	return workloads, nil
+}
+
+func (v *VSHandler) podWorkload(pod *corev1.Pod) (PVCWorkload, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return PVCWorkload{Kind: "Pod", Name: pod.GetName()}, nil
+	}
+
+	if owner.Kind != "ReplicaSet" {
+		return PVCWorkload{Kind: owner.Kind, Name: owner.Name}, nil
+	}
+
+	replicaSet := &appsv1.ReplicaSet{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: owner.Name, Namespace: pod.GetNamespace()}, replicaSet)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return PVCWorkload{Kind: owner.Kind, Name: owner.Name}, nil
+		}
+
+		return PVCWorkload{}, fmt.Errorf("failed to get replicaset %s of pod %s (%w)", owner.Name, pod.GetName(), err)
+	}
+
+	if deployment := metav1.GetControllerOf(replicaSet); deployment != nil && deployment.Kind == "Deployment" {
+		return PVCWorkload{Kind: deployment.Kind, Name: deployment.Name}, nil
+	}
+
+	return PVCWorkload{Kind: owner.Kind, Name: owner.Name}, nil
+}
+
+// QuiescePVCWorkloads returns the workloads holding the PVC, whose final sync waits for it to no longer be in use,
+// and scales down those that are Deployments or StatefulSets, if FinalSyncQuiesceWorkloads is configured
+func (v *VSHandler) QuiescePVCWorkloads(pvcNamespacedName types.NamespacedName) ([]PVCWorkload, error) {
+	workloads, err := v.PVCWorkloads(pvcNamespacedName)
+	if err != nil || len(workloads) == 0 {
+		return workloads, err
+	}
+
+	v.log.Info("PVC is held by workloads, final sync waits for them to release it",
+		"pvc", pvcNamespacedName.String(), "workloads", fmt.Sprint(workloads),
+		"quiesce", v.volSyncConfig.FinalSyncQuiesceWorkloads)
+
+	if !v.volSyncConfig.FinalSyncQuiesceWorkloads {
+		return workloads, nil
+	}
+
+	for _, workload := range workloads {
+		var obj client.Object
+
+		switch workload.Kind {
+		case "Deployment":
+			obj = &appsv1.Deployment{}
+		case "StatefulSet":
+			obj = &appsv1.StatefulSet{}
+		default:
+			continue
+		}
+
+		if err := v.scaleDownWorkload(obj, workload, pvcNamespacedName.Namespace); err != nil {
+			return workloads, err
+		}
+	}
+
+	return workloads, nil
+}
+
+func (v *VSHandler) scaleDownWorkload(obj client.Object, workload PVCWorkload, namespace string) error {
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: workload.Name, Namespace: namespace}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	var replicas **int32
+
+	switch workloadObj := obj.(type) {
+	case *appsv1.Deployment:
+		replicas = &workloadObj.Spec.Replicas
+	case *appsv1.StatefulSet:
+		replicas = &workloadObj.Spec.Replicas
+	}
+
+	// Unset replicas default to 1
+	current := int32(1)
+	if *replicas != nil {
+		current = **replicas
+	}
+
+	if current == 0 {
+		return nil
+	}
+
+	if _, recorded := obj.GetAnnotations()[QuiescedReplicasAnnotation]; !recorded {
+		util.AddAnnotation(obj, QuiescedReplicasAnnotation, strconv.Itoa(int(current)))
+		util.AddAnnotation(obj, QuiescedByAnnotation, v.quiescedBy())
+	}
+
+	*replicas = new(int32)
+
+	v.log.Info("Scaling down workload for final sync", "workload", workload.String(), "replicas", current)
+
+	if err := v.client.Update(v.ctx, obj); err != nil {
+		return fmt.Errorf("failed to scale down %s for final sync (%w)", workload, err)
+	}
+
+	return nil
+}
+
+// RestoreQuiescedWorkloads scales the Deployments and StatefulSets in the namespaces that the owner scaled down for a
+// final sync back up to their recorded replicas, to be called once the final sync is no longer prepared or run. A
+// workload scaled up by anyone else in the meantime is left as is.
+func (v *VSHandler) RestoreQuiescedWorkloads(namespaces []string) error {
+	for _, namespace := range namespaces {
+		deployments := &appsv1.DeploymentList{}
+		if err := v.client.List(v.ctx, deployments, client.InNamespace(namespace)); err != nil {
+			return fmt.Errorf("failed to list deployments in namespace %s (%w)", namespace, err)
+		}
+
+		for i := range deployments.Items {
+			deployment := &deployments.Items[i]
+			if err := v.restoreQuiescedWorkload(deployment, &deployment.Spec.Replicas); err != nil {
+				return err
+			}
+		}
+
+		statefulSets := &appsv1.StatefulSetList{}
+		if err := v.client.List(v.ctx, statefulSets, client.InNamespace(namespace)); err != nil {
+			return fmt.Errorf("failed to list statefulsets in namespace %s (%w)", namespace, err)
+		}
+
+		for i := range statefulSets.Items {
+			statefulSet := &statefulSets.Items[i]
+			if err := v.restoreQuiescedWorkload(statefulSet, &statefulSet.Spec.Replicas); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *VSHandler) restoreQuiescedWorkload(obj client.Object, replicas **int32) error {
+	annotations := obj.GetAnnotations()
+	if annotations[QuiescedByAnnotation] != v.quiescedBy() {
+		return nil
+	}
+
+	recorded, err := strconv.ParseInt(annotations[QuiescedReplicasAnnotation], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid quiesced replicas of workload %s (%w)", client.ObjectKeyFromObject(obj), err)
+	}
+
+	delete(annotations, QuiescedReplicasAnnotation)
+	delete(annotations, QuiescedByAnnotation)
+	obj.SetAnnotations(annotations)
+
+	if *replicas != nil && **replicas == 0 {
+		restored := int32(recorded)
+		*replicas = &restored
+
+		v.log.Info("Scaling up workload quiesced for final sync", "name", obj.GetName(),
+			"namespace", obj.GetNamespace(), "replicas", restored)
+	}
+
+	if err := v.client.Update(v.ctx, obj); err != nil {
+		return fmt.Errorf("failed to scale up %s quiesced for final sync (%w)", obj.GetName(), err)
+	}
+
+	return nil
+}
+
+func (v *VSHandler) quiescedBy() string {
+	return types.NamespacedName{Name: v.owner.GetName(), Namespace: v.owner.GetNamespace()}.String()
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -24,9 +25,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -55,6 +59,10 @@ type VolumeReplicationGroupReconciler struct {
 	kubeObjects         kubeobjects.RequestsManager
 	RateLimiter         *workqueue.RateLimiter
 	veleroCRsAreWatched bool
+	// PVCs can be restored with dataSourceRef, as detected from the Kubernetes server version
+	pvcDataSourceRefSupported bool
+	// VRG namespaced name to the time its objects were last listed in its S3 stores to estimate their size
+	objectStoreSizeListedAt sync.Map
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -65,6 +73,8 @@ func (r *VolumeReplicationGroupReconciler) SetupWithManager(
 
 	r.Log.Info("Adding VolumeReplicationGroup controller")
 
+	r.pvcDataSourceRefSupported = r.detectPVCDataSourceRefSupport(mgr)
+
 	rateLimiter := workqueue.NewMaxOfRateLimiter(
 		workqueue.NewItemExponentialFailureRateLimiter(1*time.Second, 1*time.Minute),
 		// defaults from client-go
@@ -118,6 +128,28 @@ func (r *VolumeReplicationGroupReconciler) SetupWithManager(
 	return ctrlBuilder.Complete(r)
 }
 
+func (r *VolumeReplicationGroupReconciler) detectPVCDataSourceRefSupport(mgr ctrl.Manager) bool {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		r.Log.Info("Unable to create discovery client, restoring PVCs with dataSource", "error", err)
+
+		return false
+	}
+
+	serverVersion, err := discoveryClient.ServerVersion()
+	if err != nil {
+		r.Log.Info("Unable to get Kubernetes server version, restoring PVCs with dataSource", "error", err)
+
+		return false
+	}
+
+	supported := volsync.PVCDataSourceRefSupported(serverVersion.GitVersion)
+	r.Log.Info("Detected PVC dataSourceRef support", "serverVersion", serverVersion.GitVersion,
+		"supported", supported)
+
+	return supported
+}
+
 type objectToReconcileRequestsMapper struct {
 	reader client.Reader
 	log    logr.Logger
@@ -366,18 +398,25 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get;list;watch;update;patch;create
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;update;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=core,resources=events,verbs=get;create;patch;update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;create;patch;update
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ramendr.openshift.io,resources=recipes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=list;watch
 // +kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=get
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -439,7 +478,11 @@ func (r *VolumeReplicationGroupReconciler) Reconcile(ctx context.Context, req ct
 
 	v.volSyncHandler = volsync.NewVSHandler(ctx, r.Client, log, v.instance,
 		v.instance.Spec.Async, cephFSCSIDriverNameOrDefault(v.ramenConfig),
-		volSyncDestinationCopyMethodOrDefault(v.ramenConfig), adminNamespaceVRG)
+		volSyncDestinationCopyMethodOrDefault(v.ramenConfig), adminNamespaceVRG, &v.ramenConfig.VolSync)
+	v.volSyncHandler.SetAPIReader(r.APIReader)
+	v.volSyncHandler.SetPVCDataSourceRefSupported(r.pvcDataSourceRefSupported)
+	v.volSyncHandler.SetRequiredRestoredPVCLabels(v.instance.Spec.VolSync.RequiredRestoredPVCLabels)
+	v.volSyncHandler.SetTriggerMode(v.instance.Spec.VolSync.TriggerMode)
 
 	if v.instance.Status.ProtectedPVCs == nil {
 		v.instance.Status.ProtectedPVCs = []ramendrv1alpha1.ProtectedPVC{}
@@ -500,6 +543,8 @@ const (
 	pvVRAnnotationRetentionValue     = "retained"
 	RestoreAnnotation                = "volumereplicationgroups.ramendr.openshift.io/ramen-restore"
 	RestoredByRamen                  = "True"
+	VolSyncPausedAnnotation          = "volumereplicationgroups.ramendr.openshift.io/volsync-paused"
+	VolSyncPausedAnnotationVal       = "true"
 
 	// StorageClass label
 	StorageIDLabel = "ramendr.openshift.io/storageid"
@@ -778,16 +823,8 @@ func (v *VRGInstance) processForDeletion() ctrl.Result {
 
 	defer v.log.Info("Exiting processing VolumeReplicationGroup")
 
-	if err := v.disownPVCs(); err != nil {
-		v.log.Info("Disowning PVCs failed", "error", err)
-
-		return ctrl.Result{Requeue: true}
-	}
-
-	if err := v.cleanupResources(); err != nil {
-		v.log.Info("Cleanup owned resources failed", "error", err)
-
-		return ctrl.Result{Requeue: true}
+	if result, requeue := v.processVolSyncForDeletion(); requeue {
+		return result
 	}
 
 	if !containsString(v.instance.ObjectMeta.Finalizers, vrgFinalizerName) {
@@ -818,12 +855,20 @@ func (v *VRGInstance) processForDeletion() ctrl.Result {
 		}
 	}
 
+	if v.volSyncPaused() {
+		v.log.Info("VolSync is paused, keeping the finalizer until VolSync is cleaned up")
+
+		return v.updateVRGStatus(ctrl.Result{})
+	}
+
 	if err := v.removeFinalizer(vrgFinalizerName); err != nil {
 		v.log.Info("Failed to remove finalizer", "finalizer", vrgFinalizerName, "errorValue", err)
 
 		return ctrl.Result{Requeue: true}
 	}
 
+	v.deleteDataSize()
+
 	rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeNormal,
 		rmnutil.EventReasonDeleteSuccess, "Deletion Success")
 
@@ -1142,8 +1187,10 @@ func (v *VRGInstance) updateVRGStatus(result ctrl.Result) ctrl.Result {
 		return result
 	}
 
+	volSyncSummary := v.volSyncHandler.ReconcileSummary()
 	v.log.Info(fmt.Sprintf("Nothing to update VolRep pvccount (%d), VolSync pvccount(%d)",
-		len(v.volRepPVCs), len(v.volSyncPVCs)))
+		len(v.volRepPVCs), len(v.volSyncPVCs)), "VolSync changed", volSyncSummary.Changed(),
+		"VolSync unchanged count", volSyncSummary.Unchanged)
 
 	return result
 }
@@ -1264,6 +1311,8 @@ func (v *VRGInstance) updateVRGConditions() {
 		volSyncDataProtected, volSyncClusterDataProtected = v.aggregateVolSyncDataProtectedConditions()
 	}
 
+	v.updateVolSyncPausedCondition()
+
 	logAndSet(VRGConditionTypeDataReady,
 		volSyncDataReady,
 		v.aggregateVolRepDataReadyCondition(),
@@ -1282,6 +1331,7 @@ func (v *VRGInstance) updateVRGConditions() {
 	v.updateVRGLastGroupSyncTime()
 	v.updateVRGLastGroupSyncDuration()
 	v.updateLastGroupSyncBytes()
+	v.updateDataSize()
 }
 
 func (v *VRGInstance) vrgReadyStatus(reason string) *metav1.Condition {
@@ -1518,7 +1568,57 @@ func (r *VolumeReplicationGroupReconciler) addVolsyncOwnsAndWatches(ctrlBuilder
 			builder.WithPredicates(rmnutil.CreateOrDeleteOrResourceVersionUpdatePredicate{}),
 		)
 
-	return ctrlBuilder
+	return r.addServiceExportWatch(ctrlBuilder)
+}
+
+// addServiceExportWatch watches for deleted ServiceExports of ReplicationDestinations, so that they are recreated
+// rather than left missing until the VRG happens to be reconciled again
+func (r *VolumeReplicationGroupReconciler) addServiceExportWatch(ctrlBuilder *builder.Builder) *builder.Builder {
+	const serviceExportCRDName = "serviceexports." + volsync.ServiceExportGroup
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.APIReader.Get(context.TODO(), types.NamespacedName{Name: serviceExportCRDName}, crd); err != nil {
+		r.Log.Info("Cannot fetch ServiceExport CRD; deleted ServiceExports will not be watched",
+			"CRD", serviceExportCRDName, "error", err)
+
+		return ctrlBuilder
+	}
+
+	svcExport := &unstructured.Unstructured{}
+	svcExport.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   volsync.ServiceExportGroup,
+		Kind:    volsync.ServiceExportKind,
+		Version: volsync.ServiceExportVersion,
+	})
+
+	return ctrlBuilder.Watches(svcExport,
+		handler.EnqueueRequestsFromMapFunc(r.serviceExportMapFunc),
+		builder.WithPredicates(predicate.Funcs{
+			CreateFunc:  func(event.CreateEvent) bool { return false },
+			UpdateFunc:  func(event.UpdateEvent) bool { return false },
+			DeleteFunc:  func(event.DeleteEvent) bool { return true },
+			GenericFunc: func(event.GenericEvent) bool { return false },
+		}),
+	)
+}
+
+func (r *VolumeReplicationGroupReconciler) serviceExportMapFunc(ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	vrgName, ok := obj.GetLabels()[volsync.VRGOwnerNameLabel]
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	vrgNamespace, ok := obj.GetLabels()[volsync.VRGOwnerNamespaceLabel]
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	ctrl.Log.WithName("serviceexportmap").WithName("VolumeReplicationGroup").Info("ServiceExport deleted",
+		"serviceExport", types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()},
+		"vrg", types.NamespacedName{Name: vrgName, Namespace: vrgNamespace})
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: vrgName, Namespace: vrgNamespace}}}
 }
 
 func (r *VolumeReplicationGroupReconciler) addKubeObjectsOwnsAndWatches(ctrlBuilder *builder.Builder) *builder.Builder {
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// objectStoreSizeRefreshInterval bounds how often the objects of a VRG are listed in its S3 stores to estimate their
+// size, as listing is expensive for VRGs with many objects
+const objectStoreSizeRefreshInterval = 10 * time.Minute
+
+// updateDataSize updates the status and metrics of the size of the data protected by the VRG and of its objects in
+// the S3 stores
+func (v *VRGInstance) updateDataSize() {
+	v.updateProtectedDataBytes()
+	v.updateObjectStoreBytes()
+
+	metrics := NewDataSizeMetrics(DataSizeMetricLabels(v.instance))
+
+	if v.instance.Status.ProtectedDataBytes != nil {
+		metrics.ProtectedDataBytes.Set(float64(*v.instance.Status.ProtectedDataBytes))
+	}
+
+	if v.instance.Status.ObjectStoreBytes != nil {
+		metrics.ObjectStoreDataBytes.Set(float64(*v.instance.Status.ObjectStoreBytes))
+	}
+
+	v.updateVolSyncProtectedBytes(metrics)
+}
+
+// updateVolSyncProtectedBytes reports the data protected by the ReplicationDestinations of a secondary VRG
+func (v *VRGInstance) updateVolSyncProtectedBytes(metrics DataSizeMetrics) {
+	if v.volSyncHandler == nil || v.instance.Spec.VolSync.Disabled ||
+		v.instance.Spec.ReplicationState != ramendrv1alpha1.Secondary ||
+		!v.instance.GetDeletionTimestamp().IsZero() {
+		return
+	}
+
+	totalBytes, err := v.volSyncHandler.TotalProtectedBytes()
+	if err != nil {
+		v.log.Info("Failed to total the data protected by the ReplicationDestinations", "error", err)
+
+		return
+	}
+
+	metrics.VolSyncProtectedDataBytes.Set(float64(totalBytes))
+}
+
+// updateProtectedDataBytes sums the storage requested by all protected PVCs
+func (v *VRGInstance) updateProtectedDataBytes() {
+	var totalBytes *int64
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		storage, ok := protectedPVC.Resources.Requests[corev1.ResourceStorage]
+		if !ok {
+			continue
+		}
+
+		if totalBytes == nil {
+			totalBytes = new(int64)
+		}
+
+		*totalBytes += storage.Value()
+	}
+
+	v.instance.Status.ProtectedDataBytes = totalBytes
+}
+
+// updateObjectStoreBytes sums the size of the objects of the VRG in all its S3 stores, keeping the last sum until
+// objectStoreSizeRefreshInterval elapses or if listing any store fails
+func (v *VRGInstance) updateObjectStoreBytes() {
+	if len(v.s3StoreAccessors) == 0 || !v.instance.GetDeletionTimestamp().IsZero() {
+		return
+	}
+
+	if listedAt, ok := v.reconciler.objectStoreSizeListedAt.Load(v.namespacedName); ok &&
+		v.instance.Status.ObjectStoreBytes != nil &&
+		time.Since(listedAt.(time.Time)) < objectStoreSizeRefreshInterval {
+		return
+	}
+
+	var totalBytes int64
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		size, err := s3StoreAccessor.ObjectStorer.ObjectsSize(v.s3KeyPrefix())
+		if err != nil {
+			v.log.Info("Failed to list objects to estimate their size", "s3Profile", s3StoreAccessor.S3ProfileName,
+				"error", err)
+
+			return
+		}
+
+		totalBytes += size
+	}
+
+	v.instance.Status.ObjectStoreBytes = &totalBytes
+	v.reconciler.objectStoreSizeListedAt.Store(v.namespacedName, time.Now())
+}
+
+// deleteDataSize forgets the size of the data of the deleted VRG
+func (v *VRGInstance) deleteDataSize() {
+	DeleteDataSizeMetrics(DataSizeMetricLabels(v.instance))
+	v.reconciler.objectStoreSizeListedAt.Delete(v.namespacedName)
+}
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/go-logr/logr"
@@ -659,9 +661,85 @@ func (v *VRGInstance) UploadPVAndPVCtoS3(s3ProfileName string, objectStore Objec
 		return err
 	}
 
+	v.uploadPVCEventsToS3(s3ProfileName, objectStore, pvc)
+
 	return nil
 }
 
+// uploadPVCEventsToS3 captures the recent events of the PVC into the object store, when enabled, to help diagnose a
+// failover of the PVC. Events are best effort and a failure to capture them does not fail protecting the PVC.
+func (v *VRGInstance) uploadPVCEventsToS3(s3ProfileName string, objectStore ObjectStorer,
+	pvc *corev1.PersistentVolumeClaim,
+) {
+	if !v.ramenConfig.PVCEventsCapture.Enabled {
+		return
+	}
+
+	log := logWithPvcName(v.log, pvc).WithValues("s3Profile", s3ProfileName)
+
+	events := &corev1.EventList{}
+	if err := v.reconciler.APIReader.List(v.ctx, events,
+		client.InNamespace(pvc.Namespace),
+		client.MatchingFields{
+			"involvedObject.kind": "PersistentVolumeClaim",
+			"involvedObject.name": pvc.Name,
+		},
+	); err != nil {
+		log.Info("Failed to list PVC events", "error", err)
+
+		return
+	}
+
+	events.Items = RecentEvents(events.Items,
+		pvcEventsCaptureMaxEventsOrDefault(v.ramenConfig),
+		pvcEventsCaptureMaxAgeOrDefault(v.ramenConfig),
+		time.Now(),
+	)
+
+	pvcNamespacedNameString := client.ObjectKeyFromObject(pvc).String()
+
+	if err := UploadPVCEvents(objectStore, v.s3KeyPrefix(), pvcNamespacedNameString, *events); err != nil {
+		log.Info("Failed to upload PVC events", "error", err)
+
+		return
+	}
+
+	log.V(1).Info("Uploaded PVC events", "count", len(events.Items))
+}
+
+// RecentEvents returns, most recently seen first, at most maxEvents of the events last seen within maxAge of now.
+func RecentEvents(events []corev1.Event, maxEvents int, maxAge time.Duration, now time.Time) []corev1.Event {
+	recent := make([]corev1.Event, 0, len(events))
+
+	for i := range events {
+		if now.Sub(eventLastSeen(&events[i])) <= maxAge {
+			recent = append(recent, events[i])
+		}
+	}
+
+	sort.SliceStable(recent, func(i, j int) bool {
+		return eventLastSeen(&recent[i]).After(eventLastSeen(&recent[j]))
+	})
+
+	if len(recent) > maxEvents {
+		recent = recent[:maxEvents]
+	}
+
+	return recent
+}
+
+func eventLastSeen(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+
+	return event.CreationTimestamp.Time
+}
+
 func (v *VRGInstance) UploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim,
 	log logr.Logger,
 ) ([]string, error) {
@@ -953,6 +1031,7 @@ func (v *VRGInstance) pvAndPvcObjectReplicasDelete(pvc corev1.PersistentVolumeCl
 	keys := []string{
 		TypedObjectKey(keyPrefix, pvc.Spec.VolumeName, corev1.PersistentVolume{}),
 		TypedObjectKey(keyPrefix, pvcNamespacedName.String(), corev1.PersistentVolumeClaim{}),
+		TypedObjectKey(keyPrefix, pvcNamespacedName.String(), corev1.EventList{}),
 	}
 
 	if pvc.Namespace == vrg.Namespace {
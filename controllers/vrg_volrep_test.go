@@ -545,6 +545,13 @@ var _ = Describe("VolumeReplicationGroupVolRepController", func() {
 			vrgS3UploadTestCase.verifyVRGStatusExpectation(true, vrgController.VRGConditionReasonReady)
 			vrgS3UploadTestCase.verifyCachedUploadError()
 		})
+		It("reports the storage requested by the protected PVCs", func() {
+			pvcBytes := resource.MustParse("1Gi")
+			Eventually(func() *int64 {
+				return vrgS3UploadTestCase.getVRG().Status.ProtectedDataBytes
+			}, vrgtimeout, vrginterval).Should(HaveValue(Equal(
+				int64(len(vrgS3UploadTestCase.pvcNames)) * pvcBytes.Value())))
+		})
 		Specify("set VRG's S3 profile names to empty", func() {
 			vrgS3UploadTestCase.vrgS3ProfilesSet([]string{vrgController.NoS3StoreAvailable})
 		})
@@ -1011,6 +1018,47 @@ var _ = Describe("VolumeReplicationGroupVolRepController", func() {
 	// TODO: Add tests to ensure delete as Secondary (check if delete as Primary is tested above)
 })
 
+var _ = Describe("RecentEvents", func() {
+	now := time.Now()
+	event := func(name string, lastSeen time.Duration) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: name},
+			LastTimestamp: metav1.NewTime(now.Add(-lastSeen)),
+		}
+	}
+	eventNames := func(events []corev1.Event) []string {
+		names := make([]string, len(events))
+		for i := range events {
+			names[i] = events[i].Name
+		}
+
+		return names
+	}
+	events := []corev1.Event{
+		event("e1", 3*time.Minute),
+		event("e2", time.Minute),
+		event("e3", 2*time.Hour),
+		event("e4", 2*time.Minute),
+	}
+
+	It("returns the events last seen within the maximum age, most recent first", func() {
+		Expect(eventNames(vrgController.RecentEvents(events, 10, time.Hour, now))).To(
+			Equal([]string{"e2", "e4", "e1"}))
+	})
+	It("returns at most the maximum number of events", func() {
+		Expect(eventNames(vrgController.RecentEvents(events, 2, time.Hour, now))).To(
+			Equal([]string{"e2", "e4"}))
+	})
+	It("falls back to the event time when the last timestamp is not set", func() {
+		e := corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "e5"},
+			EventTime:  metav1.NewMicroTime(now),
+		}
+		Expect(eventNames(vrgController.RecentEvents(append(events, e), 1, time.Hour, now))).To(
+			Equal([]string{"e5"}))
+	})
+})
+
 type vrgTest struct {
 	uniqueID             string
 	namespace            string
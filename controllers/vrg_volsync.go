@@ -4,16 +4,22 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/go-logr/logr"
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/controllers/util"
 	"github.com/ramendr/ramen/controllers/volsync"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 func (v *VRGInstance) restorePVsAndPVCsForVolSync() (int, error) {
@@ -25,12 +31,35 @@ func (v *VRGInstance) restorePVsAndPVCsForVolSync() (int, error) {
 		return 0, nil
 	}
 
+	if v.volSyncPaused() {
+		return 0, fmt.Errorf("VolSync is paused, not restoring PVCs using RDSpec (%v)", v.instance.Spec.VolSync.RDSpec)
+	}
+
 	numPVsRestored := 0
 
 	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
 		failoverAction := v.instance.Spec.Action == ramendrv1alpha1.VRGActionFailover
 		// Create a PVC from snapshot or for direct copy
 		err := v.volSyncHandler.EnsurePVCfromRD(rdSpec, failoverAction)
+		if errors.Is(err, volsync.ErrPVCBelowMinSize) {
+			v.log.Info(fmt.Sprintf("Not restoring PVC %v -- err: %v", rdSpec, err))
+
+			protectedPVC := FindProtectedPVC(v.instance, rdSpec.ProtectedPVC.Namespace, rdSpec.ProtectedPVC.Name)
+			if protectedPVC == nil {
+				protectedPVC = &ramendrv1alpha1.ProtectedPVC{}
+				rdSpec.ProtectedPVC.DeepCopyInto(protectedPVC)
+				v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, *protectedPVC)
+				protectedPVC = &v.instance.Status.ProtectedPVCs[len(v.instance.Status.ProtectedPVCs)-1]
+			}
+
+			setVRGConditionTypeVolSyncPVRestoreSkippedTooSmall(&protectedPVC.Conditions, v.instance.Generation,
+				err.Error())
+
+			numPVsRestored++
+
+			continue
+		}
+
 		if err != nil {
 			v.log.Info(fmt.Sprintf("Unable to ensure PVC %v -- err: %v", rdSpec, err))
 
@@ -41,8 +70,23 @@ func (v *VRGInstance) restorePVsAndPVCsForVolSync() (int, error) {
 				v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, *protectedPVC)
 			}
 
-			setVRGConditionTypeVolSyncPVRestoreError(&protectedPVC.Conditions, v.instance.Generation,
-				fmt.Sprintf("%v", err))
+			switch {
+			case errors.Is(err, volsync.ErrAccessModeNotSupported):
+				setVRGConditionTypeVolSyncPVRestoreAccessModeNotSupported(&protectedPVC.Conditions,
+					v.instance.Generation, err.Error())
+			case errors.Is(err, volsync.ErrSnapshotNotReady):
+				setVRGConditionTypeVolSyncPVRestoreSnapshotNotReady(&protectedPVC.Conditions,
+					v.instance.Generation, err.Error())
+			case errors.Is(err, volsync.ErrPVCTerminating):
+				setVRGConditionTypeVolSyncPVRestorePVCTerminating(&protectedPVC.Conditions,
+					v.instance.Generation, err.Error())
+			case errors.Is(err, volsync.ErrRequiredPVCLabelsMissing):
+				setVRGConditionTypeVolSyncPVRestoreRequiredLabelsMissing(&protectedPVC.Conditions,
+					v.instance.Generation, err.Error())
+			default:
+				setVRGConditionTypeVolSyncPVRestoreError(&protectedPVC.Conditions, v.instance.Generation,
+					fmt.Sprintf("%v", err))
+			}
 
 			continue // Keep trying to ensure PVCs for other rdSpec
 		}
@@ -74,20 +118,31 @@ func (v *VRGInstance) reconcileVolSyncAsPrimary(finalSyncPrepared *bool) (requeu
 		v.instance.Status.FinalSyncComplete = v.instance.Spec.RunFinalSync
 	}
 
+	if v.recreateMissingRestoredVolSyncPVCs() {
+		requeue = true
+
+		return
+	}
+
+	requeue = v.reconcileVolSyncMissingSourcePVCs()
+
 	if len(v.volSyncPVCs) == 0 {
-		finalSyncComplete()
+		if !requeue {
+			finalSyncComplete()
+		}
 
 		return
 	}
 
-	v.log.Info(fmt.Sprintf("Reconciling VolSync as Primary. %d VolSyncPVCs", len(v.volSyncPVCs)))
+	if v.volSyncPaused() {
+		v.log.Info("VolSync is paused, skipping reconciling VolSync as Primary")
 
-	// Cleanup - this VRG is primary, cleanup if necessary
-	// remove any ReplicationDestinations (that would have been created when this VRG was secondary) if they
-	// are not in the RDSpec list
-	if err := v.volSyncHandler.CleanupRDNotInSpecList(v.instance.Spec.VolSync.RDSpec); err != nil {
-		v.log.Error(err, "Failed to cleanup the RDSpecs when this VRG instance was secondary")
+		return
+	}
 
+	v.log.Info(fmt.Sprintf("Reconciling VolSync as Primary. %d VolSyncPVCs", len(v.volSyncPVCs)))
+
+	if v.prepareVolSyncAsPrimary() {
 		requeue = true
 
 		return
@@ -100,6 +155,8 @@ func (v *VRGInstance) reconcileVolSyncAsPrimary(finalSyncPrepared *bool) (requeu
 		}
 	}
 
+	v.updateVolSyncReplicationReadyConditions()
+
 	if requeue {
 		v.log.Info("Not all ReplicationSources completed setup. We'll retry...")
 
@@ -112,13 +169,129 @@ func (v *VRGInstance) reconcileVolSyncAsPrimary(finalSyncPrepared *bool) (requeu
 	return requeue
 }
 
+// prepareVolSyncAsPrimary cleans up what is left of this VRG instance as secondary, restores the workloads quiesced
+// for a final sync that is no longer prepared or run, and assigns the sync slots of its ReplicationSources, before
+// they are reconciled. Returns true to requeue if any step failed or is pending.
+func (v *VRGInstance) prepareVolSyncAsPrimary() (requeue bool) {
+	if v.reconcileVolSyncStaleOwnerNamespace() {
+		return true
+	}
+
+	if v.deferVolSyncRDCleanup() {
+		return true
+	}
+
+	if v.cleanupVolSyncReplicationConflicts(v.volSyncHandler.DeleteRD) {
+		return true
+	}
+
+	// Cleanup - this VRG is primary, cleanup if necessary
+	// remove any ReplicationDestinations (that would have been created when this VRG was secondary) if they
+	// are not in the RDSpec list
+	if err := v.volSyncHandler.CleanupRDNotInSpecList(v.instance.Spec.VolSync.RDSpec); err != nil {
+		v.log.Error(err, "Failed to cleanup the RDSpecs when this VRG instance was secondary")
+
+		return true
+	}
+
+	if !v.instance.Spec.PrepareForFinalSync && !v.instance.Spec.RunFinalSync {
+		if err := v.volSyncHandler.RestoreQuiescedWorkloads(volSyncPVCNamespaces(v.volSyncPVCs)); err != nil {
+			v.log.Error(err, "Failed to restore the workloads quiesced for final sync")
+
+			return true
+		}
+	}
+
+	if err := v.volSyncHandler.AssignSyncSlots(volSyncPVCNames(v.volSyncPVCs)); err != nil {
+		v.log.Error(err, "Failed to assign the sync slots of the ReplicationSources")
+
+		return true
+	}
+
+	return false
+}
+
+func volSyncPVCNames(pvcs []corev1.PersistentVolumeClaim) []types.NamespacedName {
+	names := make([]types.NamespacedName, 0, len(pvcs))
+	for i := range pvcs {
+		names = append(names, types.NamespacedName{Name: pvcs[i].GetName(), Namespace: pvcs[i].GetNamespace()})
+	}
+
+	return names
+}
+
+func volSyncPVCNamespaces(pvcs []corev1.PersistentVolumeClaim) []string {
+	namespaces := []string{}
+	found := map[string]bool{}
+
+	for i := range pvcs {
+		if namespace := pvcs[i].GetNamespace(); !found[namespace] {
+			found[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces
+}
+
+// reconcileVolSyncMissingSourcePVCs handles the ReplicationSources of the VRG whose source PVC is missing, and thus
+// no longer among the VolSync PVCs, and reports it in their protected PVC status. Returns true to requeue if any
+// source PVC is missing, or handling them failed.
+func (v *VRGInstance) reconcileVolSyncMissingSourcePVCs() (requeue bool) {
+	if v.instance.Spec.RunFinalSync || v.volSyncPaused() {
+		return false
+	}
+
+	missing, err := v.volSyncHandler.HandleMissingSourcePVCs(volSyncPVCNames(v.volSyncPVCs))
+	if err != nil {
+		v.log.Error(err, "Failed to handle the ReplicationSources of missing source PVCs")
+
+		return true
+	}
+
+	for _, pvc := range missing {
+		if protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name); protectedPVC != nil {
+			setVRGConditionTypeVolSyncRepSourceSetupSourcePVCMissing(&protectedPVC.Conditions, v.instance.Generation,
+				fmt.Sprintf("%v, pvc: %s", volsync.ErrSourcePVCMissing, pvc.String()))
+		}
+	}
+
+	return len(missing) > 0
+}
+
+// recreateMissingRestoredVolSyncPVCs recreates the PVCs restored from the RDSpec list that were deleted externally
+// before they were protected by a ReplicationSource, as their ReplicationDestinations are still healthy. Returns true
+// to requeue if any PVC was recreated, or failed to be.
+func (v *VRGInstance) recreateMissingRestoredVolSyncPVCs() (requeue bool) {
+	if v.instance.Spec.RunFinalSync || v.volSyncPaused() {
+		return false
+	}
+
+	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
+		recreated, err := v.volSyncHandler.RecreateMissingRestoredPVC(rdSpec)
+		if err != nil {
+			v.log.Error(err, "Failed to recreate missing restored PVC", "pvcName", rdSpec.ProtectedPVC.Name)
+
+			requeue = true
+
+			continue
+		}
+
+		if recreated {
+			requeue = true
+		}
+	}
+
+	return requeue
+}
+
 func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeClaim) (requeue bool) {
 	newProtectedPVC := &ramendrv1alpha1.ProtectedPVC{
 		Name:               pvc.Name,
 		Namespace:          pvc.Namespace,
 		ProtectedByVolSync: true,
 		StorageClassName:   pvc.Spec.StorageClassName,
-		Annotations:        protectedPVCAnnotations(pvc),
+		Annotations:        protectedPVCAnnotations(pvc, v.ramenConfig.VolSync.ProtectedPVCAnnotations),
 		Labels:             pvc.Labels,
 		AccessModes:        pvc.Spec.AccessModes,
 		Resources:          pvc.Spec.Resources,
@@ -130,6 +303,13 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, *protectedPVC)
 	} else if !reflect.DeepEqual(protectedPVC, newProtectedPVC) {
 		newProtectedPVC.Conditions = protectedPVC.Conditions
+		// Kept for the mover pod of a failed sync to be reported once VolSync deletes it
+		newProtectedPVC.LastSyncFailure = protectedPVC.LastSyncFailure
+		// Kept for the import snapshot, peer address and initial sync estimate to be reported if the
+		// ReplicationSource is not reconciled
+		newProtectedPVC.SnapshotImport = protectedPVC.SnapshotImport
+		newProtectedPVC.RemoteAddress = protectedPVC.RemoteAddress
+		newProtectedPVC.InitialSyncEstimate = protectedPVC.InitialSyncEstimate
 		newProtectedPVC.DeepCopyInto(protectedPVC)
 	}
 
@@ -139,21 +319,56 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		ProtectedPVC: *protectedPVC,
 	}
 
+	takeOwnership := v.instance.Spec.PrepareForFinalSync || v.volSyncHandler.IsCopyMethodDirect()
+
 	err := v.volSyncHandler.PreparePVC(util.ProtectedPVCNamespacedName(*protectedPVC),
 		v.instance.Spec.PrepareForFinalSync,
 		v.volSyncHandler.IsCopyMethodDirect())
 	if err != nil {
+		if takeOwnership {
+			setVRGConditionTypeVolSyncAppsubOwnershipReleasePending(&protectedPVC.Conditions, v.instance.Generation,
+				err.Error())
+		}
+
 		return true
 	}
 
+	if takeOwnership {
+		setVRGConditionTypeVolSyncAppsubOwnershipReleased(&protectedPVC.Conditions, v.instance.Generation,
+			"PVC owned by VRG")
+	}
+
 	// reconcile RS and if runFinalSync is true, then one final sync will be run
 	finalSyncComplete, rs, err := v.volSyncHandler.ReconcileRS(rsSpec, v.instance.Spec.RunFinalSync)
 	if err != nil {
 		v.log.Info(fmt.Sprintf("Failed to reconcile VolSync Replication Source for rsSpec %v. Error %v",
 			rsSpec, err))
 
-		setVRGConditionTypeVolSyncRepSourceSetupError(&protectedPVC.Conditions, v.instance.Generation,
-			"VolSync setup failed")
+		switch {
+		case errors.Is(err, volsync.ErrVolumeSnapshotClassDriverMismatch):
+			setVRGConditionTypeVolSyncRepSourceSetupSnapshotClassMismatch(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+		case errors.Is(err, volsync.ErrSourcePVCMissing):
+			setVRGConditionTypeVolSyncRepSourceSetupSourcePVCMissing(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+		case errors.Is(err, volsync.ErrVolSyncRBACMissing):
+			setVRGConditionTypeVolSyncRepSourceSetupRBACMissing(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+		case errors.Is(err, volsync.ErrPVCTerminating):
+			setVRGConditionTypeVolSyncRepSourceSetupPVCTerminating(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+		case errors.Is(err, volsync.ErrFinalSyncTimedOut):
+			setVRGConditionTypeVolSyncRepSourceSetupFinalSyncTimedOut(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+		case errors.Is(err, volsync.ErrPVCBelowMinSize):
+			setVRGConditionTypeVolSyncRepSourceSetupSkippedTooSmall(&protectedPVC.Conditions,
+				v.instance.Generation, err.Error())
+
+			return false
+		default:
+			setVRGConditionTypeVolSyncRepSourceSetupError(&protectedPVC.Conditions, v.instance.Generation,
+				"VolSync setup failed")
+		}
 
 		return true
 	}
@@ -169,12 +384,54 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		protectedPVC.LastSyncDuration = rs.Status.LastSyncDuration
 	}
 
+	protectedPVC.RemoteAddress = volsync.GetReplicationSourceAddress(rs)
+	protectedPVC.InitialSyncEstimate = nil
+
+	if protectedPVC.LastSyncTime == nil {
+		estimate, err := v.volSyncHandler.EstimateInitialSync(*protectedPVC)
+		if err != nil {
+			v.log.Info(fmt.Sprintf("Failed to estimate the initial sync of rsSpec %v. Error %v", rsSpec, err))
+		} else {
+			protectedPVC.InitialSyncEstimate = &metav1.Duration{Duration: estimate.Duration}
+		}
+	}
+
+	lastSyncFailure, err := v.volSyncHandler.MoverFailure(rs, protectedPVC.LastSyncFailure)
+	if err != nil {
+		v.log.Info(fmt.Sprintf("Failed to get the mover failure of rsSpec %v. Error %v", rsSpec, err))
+	} else {
+		protectedPVC.LastSyncFailure = lastSyncFailure
+	}
+
+	snapshotImport, err := v.volSyncHandler.EnsureImportSnapshot(rsSpec)
+	if err != nil {
+		v.log.Info(fmt.Sprintf("Failed to ensure import snapshot for rsSpec %v. Error %v", rsSpec, err))
+
+		return true
+	}
+
+	protectedPVC.SnapshotImport = snapshotImport
+
 	return v.instance.Spec.RunFinalSync && !finalSyncComplete
 }
 
 func (v *VRGInstance) reconcileVolSyncAsSecondary() bool {
+	if v.volSyncPaused() {
+		v.log.Info("VolSync is paused, skipping reconciling VolSync as Secondary")
+
+		return false
+	}
+
 	v.log.Info("Reconcile VolSync as Secondary", "RDSpec", v.instance.Spec.VolSync.RDSpec)
 
+	if v.reconcileVolSyncStaleOwnerNamespace() {
+		return true
+	}
+
+	if v.cleanupVolSyncReplicationConflicts(v.volSyncHandler.DeleteRS) {
+		return true
+	}
+
 	// If we are secondary, and RDSpec is not set, then we don't want to have any PVC
 	// flagged as a VolSync PVC.
 	if v.instance.Spec.VolSync.RDSpec == nil {
@@ -205,6 +462,22 @@ func (v *VRGInstance) reconcileRDSpecForDeletionOrReplication() bool {
 		v.log.Info("Reconcile RD as Secondary", "RDSpec", rdSpec)
 
 		rd, err := v.volSyncHandler.ReconcileRD(rdSpec)
+		if errors.Is(err, volsync.ErrPVCBelowMinSize) {
+			continue
+		}
+
+		if errors.Is(err, volsync.ErrAccessModeNotSupported) {
+			v.log.Info("Access modes of the PVC not supported, skipping its ReplicationDestination",
+				"pvcName", rdSpec.ProtectedPVC.Name, "error", err.Error())
+
+			setVRGConditionTypeVolSyncRepDestinationSetupAccessModeNotSupported(
+				&v.findOrAddVolSyncProtectedPVC(rdSpec.ProtectedPVC).Conditions, v.instance.Generation, err.Error())
+
+			requeue = true
+
+			continue // Keep reconciling the other RDSpecs
+		}
+
 		if err != nil {
 			v.log.Error(err, "Failed to reconcile VolSync Replication Destination")
 
@@ -218,6 +491,12 @@ func (v *VRGInstance) reconcileRDSpecForDeletionOrReplication() bool {
 				rdSpec.ProtectedPVC.Name))
 
 			requeue = true
+
+			continue
+		}
+
+		if v.recoverMissingVolSyncDestinationPVC(rd) {
+			requeue = true
 		}
 	}
 
@@ -228,6 +507,208 @@ func (v *VRGInstance) reconcileRDSpecForDeletionOrReplication() bool {
 	return requeue
 }
 
+// findOrAddVolSyncProtectedPVC returns the status of the protected PVC, adding it to the VRG status if missing
+func (v *VRGInstance) findOrAddVolSyncProtectedPVC(protectedPVC ramendrv1alpha1.ProtectedPVC,
+) *ramendrv1alpha1.ProtectedPVC {
+	if found := v.findProtectedPVC(protectedPVC.Namespace, protectedPVC.Name); found != nil {
+		return found
+	}
+
+	v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, *protectedPVC.DeepCopy())
+
+	return &v.instance.Status.ProtectedPVCs[len(v.instance.Status.ProtectedPVCs)-1]
+}
+
+// recoverMissingVolSyncDestinationPVC re-drives the creation of the destination PVC of the ReplicationDestination
+// if it is missing, reporting it in an event, as restores from the ReplicationDestination would otherwise break
+// silently. It returns true to requeue until the destination PVC is recreated.
+func (v *VRGInstance) recoverMissingVolSyncDestinationPVC(rd *volsyncv1alpha1.ReplicationDestination) bool {
+	missing, err := v.volSyncHandler.RecoverMissingDestinationPVC(rd)
+	if err != nil {
+		v.log.Error(err, "Failed to recover missing destination PVC", "rdName", rd.GetName())
+	}
+
+	if !missing {
+		return err != nil
+	}
+
+	util.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+		util.EventReasonVolSyncDestinationPVCMissing,
+		fmt.Sprintf("Destination PVC of ReplicationDestination %s/%s is missing, recreating it",
+			rd.GetNamespace(), rd.GetName()))
+
+	return true
+}
+
+// updateVolSyncReplicationReadyConditions sets the replication ready condition of each PVC protected by VolSync from
+// its ReplicationSource, as VolSync reports it. PVCs too small to be protected have no ReplicationSource, and are left
+// as is.
+func (v *VRGInstance) updateVolSyncReplicationReadyConditions() {
+	protectedPVCs := []ramendrv1alpha1.ProtectedPVC{}
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		condition := findCondition(protectedPVC.Conditions, VRGConditionTypeVolSyncRepSourceSetup)
+		if !protectedPVC.ProtectedByVolSync ||
+			(condition != nil && condition.Reason == VRGConditionReasonSkippedTooSmall) {
+			continue
+		}
+
+		protectedPVCs = append(protectedPVCs, protectedPVC)
+	}
+
+	conditions, err := v.volSyncHandler.ReplicationConditions(protectedPVCs, v.instance.Generation)
+	if err != nil {
+		v.log.Info(fmt.Sprintf("Failed to get the VolSync replication conditions. Error %v", err))
+
+		return
+	}
+
+	for _, pvcCondition := range conditions {
+		protectedPVC := v.findProtectedPVC(pvcCondition.Namespace, pvcCondition.Name)
+		if protectedPVC == nil {
+			continue
+		}
+
+		setStatusCondition(&protectedPVC.Conditions, pvcCondition.Condition)
+	}
+}
+
+// volSyncPaused returns true if the VRG is annotated to pause VolSync. While paused, no ReplicationSource,
+// ReplicationDestination, VolumeSnapshot or PVC is created, updated or deleted for the VRG, leaving any manual
+// intervention on those resources untouched until the annotation is removed.
+func (v *VRGInstance) volSyncPaused() bool {
+	return v.instance.GetAnnotations()[VolSyncPausedAnnotation] == VolSyncPausedAnnotationVal
+}
+
+// deferVolSyncRDCleanup pauses, instead of deleting, the ReplicationDestinations of the PVCs in the RDSpec list of
+// the VRG taking over as primary, if RD cleanup is deferred, until the hub confirms the takeover by clearing the
+// list. It returns true to requeue if any could not be paused.
+func (v *VRGInstance) deferVolSyncRDCleanup() (requeue bool) {
+	if !v.ramenConfig.VolSync.DeferRDCleanup {
+		return false
+	}
+
+	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
+		err := v.volSyncHandler.DeferRDCleanup(rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
+		if err != nil {
+			v.log.Error(err, "Failed to defer ReplicationDestination cleanup", "pvcName", rdSpec.ProtectedPVC.Name)
+
+			requeue = true
+		}
+	}
+
+	return requeue
+}
+
+// volSyncRDCleanupDeferred returns true if the ReplicationDestination of the PVC is kept, paused, while the primary
+// VRG awaits the confirmation of its takeover
+func (v *VRGInstance) volSyncRDCleanupDeferred(pvc types.NamespacedName) bool {
+	if !v.ramenConfig.VolSync.DeferRDCleanup || v.instance.Spec.ReplicationState != ramendrv1alpha1.Primary {
+		return false
+	}
+
+	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
+		if rdSpec.ProtectedPVC.Name == pvc.Name && rdSpec.ProtectedPVC.Namespace == pvc.Namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cleanupVolSyncReplicationConflicts flags the PVCs that have both a ReplicationSource and a
+// ReplicationDestination in the VRG status, and deletes the object of each that does not match the VRG
+// replication state using deleteConflicting. It returns true if there were conflicts, to requeue and verify that
+// they are cleaned up.
+func (v *VRGInstance) cleanupVolSyncReplicationConflicts(deleteConflicting func(string, string) error) bool {
+	pvcs, err := v.volSyncHandler.ListPVCsWithRSAndRD()
+	if err != nil {
+		v.log.Error(err, "Failed to check for PVCs with both a ReplicationSource and a ReplicationDestination")
+
+		return true
+	}
+
+	conflicts := pvcs[:0]
+
+	for _, pvc := range pvcs {
+		if !v.volSyncRDCleanupDeferred(pvc) {
+			conflicts = append(conflicts, pvc)
+		}
+	}
+
+	pvcs = conflicts
+
+	if len(pvcs) == 0 {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeVolSyncReplicationConflict)
+
+		return false
+	}
+
+	pvcNames := make([]string, 0, len(pvcs))
+
+	for _, pvc := range pvcs {
+		pvcNames = append(pvcNames, pvc.String())
+
+		if err := deleteConflicting(pvc.Name, pvc.Namespace); err != nil {
+			v.log.Error(err, "Failed to cleanup conflicting VolSync object", "pvc", pvc.String())
+		}
+	}
+
+	setStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncReplicationConflict,
+		Reason:             VRGConditionReasonSourceAndDestinationPresent,
+		ObservedGeneration: v.instance.Generation,
+		Status:             metav1.ConditionTrue,
+		Message:            fmt.Sprintf("PVCs have both a ReplicationSource and a ReplicationDestination %v", pvcNames),
+	})
+
+	return true
+}
+
+// reconcileVolSyncStaleOwnerNamespace migrates to the VRG the ReplicationSources and ReplicationDestinations left
+// labeled as owned by it in a namespace it was previously created in, and flags in the VRG status those it cannot
+// migrate. It returns true if they could not be looked up or migrated, to requeue.
+func (v *VRGInstance) reconcileVolSyncStaleOwnerNamespace() bool {
+	migrated, stale, err := v.volSyncHandler.MigrateStaleOwnerNamespace()
+	if err != nil {
+		v.log.Error(err, "Failed to migrate VolSync objects owned by the VRG in a stale namespace", "migrated", migrated)
+
+		return true
+	}
+
+	if len(stale) == 0 {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeVolSyncStaleOwnerNamespace)
+
+		return false
+	}
+
+	setStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncStaleOwnerNamespace,
+		Reason:             VRGConditionReasonStaleOwnerNamespaceObjects,
+		ObservedGeneration: v.instance.Generation,
+		Status:             metav1.ConditionTrue,
+		Message:            fmt.Sprintf("VolSync objects owned by the VRG in a stale namespace %v", stale),
+	})
+
+	return false
+}
+
+func (v *VRGInstance) updateVolSyncPausedCondition() {
+	if !v.volSyncPaused() {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeVolSyncPaused)
+
+		return
+	}
+
+	setStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+		Type:               VRGConditionTypeVolSyncPaused,
+		Reason:             VRGConditionReasonVolSyncPaused,
+		ObservedGeneration: v.instance.Generation,
+		Status:             metav1.ConditionTrue,
+		Message:            fmt.Sprintf("VolSync is paused by annotation %s", VolSyncPausedAnnotation),
+	})
+}
+
 func (v *VRGInstance) aggregateVolSyncDataReadyCondition() *metav1.Condition {
 	dataReadyCondition := &metav1.Condition{
 		Status:             metav1.ConditionTrue,
@@ -312,6 +793,11 @@ func (v *VRGInstance) buildDataProtectedCondition() *metav1.Condition {
 				break
 			}
 
+			// PVCs too small to be protected using VolSync have no RS to sync
+			if condition.Reason == VRGConditionReasonSkippedTooSmall {
+				continue
+			}
+
 			// IFF however, we are running the final sync, then we have to wait
 			condition = findCondition(protectedPVC.Conditions, VRGConditionTypeVolSyncFinalSyncInProgress)
 			if condition != nil && condition.Status != metav1.ConditionTrue {
@@ -385,7 +871,12 @@ func (v VRGInstance) isVolSyncProtectedPVCConditionReady(conType string) bool {
 //     owned by OCM when DR is disabled. Copy all annnotations except the
 //     special "do-not-delete" annotation, used only on the source cluster
 //     during relocate.
-func protectedPVCAnnotations(pvc corev1.PersistentVolumeClaim) map[string]string {
+//   - the configured protectedKeys - e.g. the key reference of an encrypted
+//     CSI volume, required to decrypt the volume restored from its replicated
+//     data.
+//   - the restore capacity annotation - the capacity requested for the PVC
+//     when it is restored.
+func protectedPVCAnnotations(pvc corev1.PersistentVolumeClaim, protectedKeys []string) map[string]string {
 	res := map[string]string{}
 
 	for key, value := range pvc.Annotations {
@@ -395,6 +886,16 @@ func protectedPVCAnnotations(pvc corev1.PersistentVolumeClaim) map[string]string
 		}
 	}
 
+	if value, ok := pvc.Annotations[volsync.RestoreCapacityAnnotation]; ok {
+		res[volsync.RestoreCapacityAnnotation] = value
+	}
+
+	for _, key := range protectedKeys {
+		if value, ok := pvc.Annotations[key]; ok {
+			res[key] = value
+		}
+	}
+
 	return res
 }
 
@@ -408,6 +909,32 @@ func (v *VRGInstance) pvcUnprotectVolSync(pvc corev1.PersistentVolumeClaim, log
 	v.pvcStatusDeleteIfPresent(pvc.Namespace, pvc.Name, log)
 }
 
+// processVolSyncForDeletion disowns the VolSync PVCs, and cleans up the VolSync resources, of the VRG being deleted.
+// Both are deferred while VolSync is paused, the VRG finalizer being kept until then, whereas the rest of the
+// deletion proceeds. Returns true, and the result to return, if the deletion is to be requeued.
+func (v *VRGInstance) processVolSyncForDeletion() (ctrl.Result, bool) {
+	if v.volSyncPaused() {
+		v.log.Info("VolSync is paused, deferring VolSync cleanup until unpaused")
+		v.updateVolSyncPausedCondition()
+
+		return ctrl.Result{}, false
+	}
+
+	if err := v.disownPVCs(); err != nil {
+		v.log.Info("Disowning PVCs failed", "error", err)
+
+		return ctrl.Result{Requeue: true}, true
+	}
+
+	if err := v.cleanupResources(); err != nil {
+		v.log.Info("Cleanup owned resources failed", "error", err)
+
+		return v.cleanupResourcesRequeue(err), true
+	}
+
+	return ctrl.Result{}, false
+}
+
 // disownPVCs this function is disassociating all PVCs (targeted for VolSync replication) from its owner (VRG)
 func (v *VRGInstance) disownPVCs() error {
 	if v.instance.GetAnnotations()[DoNotDeletePVCAnnotation] != DoNotDeletePVCAnnotationVal {
@@ -426,8 +953,28 @@ func (v *VRGInstance) disownPVCs() error {
 	return nil
 }
 
-// cleanupResources this function deleted all PS, PD and VolumeSnapshots from its owner (VRG)
+// cleanupResourcesRequeue requeues the deletion of the VRG once the retention of its VolumeSnapshots expires, if that
+// is what cleanupResources waits for, or right away otherwise
+func (v *VRGInstance) cleanupResourcesRequeue(err error) ctrl.Result {
+	if !errors.Is(err, volsync.ErrSnapshotRetained) {
+		return ctrl.Result{Requeue: true}
+	}
+
+	retainedUntil := v.volSyncHandler.SnapshotsRetainedUntil()
+	if retainedUntil.IsZero() {
+		return ctrl.Result{Requeue: true}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Until(retainedUntil) + time.Second}
+}
+
+// cleanupResources this function deleted all PS, PD and VolumeSnapshots from its owner (VRG), and releases its
+// reference on the VolSync secret. The VolumeSnapshots whose retention has not expired are kept, and
+// volsync.ErrSnapshotRetained is returned once the other resources are cleaned up, for the deletion of the VRG to
+// wait for their retention to expire.
 func (v *VRGInstance) cleanupResources() error {
+	var retainedErr error
+
 	for idx := range v.volSyncPVCs {
 		pvc := &v.volSyncPVCs[idx]
 
@@ -440,9 +987,17 @@ func (v *VRGInstance) cleanupResources() error {
 		}
 
 		if err := v.volSyncHandler.DeleteSnapshots(pvc.Namespace); err != nil {
-			return err
+			if !errors.Is(err, volsync.ErrSnapshotRetained) {
+				return err
+			}
+
+			retainedErr = err
 		}
 	}
 
-	return nil
+	if err := v.volSyncHandler.ReleaseVolSyncSecret(); err != nil {
+		return err
+	}
+
+	return retainedErr
 }
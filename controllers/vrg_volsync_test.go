@@ -13,15 +13,20 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/controllers"
 	"github.com/ramendr/ramen/controllers/volsync"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 )
 
 const (
@@ -29,6 +34,9 @@ const (
 	testInterval            = 250 * time.Millisecond
 	testStorageClassName    = "fakestorageclass"
 	testVolumeSnapshotClass = "fakevolumesnapshotclass"
+
+	// Key reference of an encrypted volume, as set by encrypting CSI drivers
+	pvcEncryptionAnnotation = "px/secret-name"
 )
 
 var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
@@ -115,6 +123,7 @@ var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
 					volsync.ACMAppSubDoNotDeleteAnnotation:                 volsync.ACMAppSubDoNotDeleteAnnotationVal,
 					"pv.kubernetes.io/bind-completed":                      "yes",
 					"volume.kubernetes.io/storage-provisioner":             "provisioner",
+					pvcEncryptionAnnotation:                                "pvc-encryption-key",
 				}
 
 				JustBeforeEach(func() {
@@ -158,7 +167,7 @@ var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
 					Expect(foundBoundPVC2).To(BeTrue())
 				})
 
-				It("Should report only OCM annotaions in Status", func() {
+				It("Should report only OCM and protected annotaions in Status", func() {
 					for _, vsPvc := range testVsrg.Status.ProtectedPVCs {
 						// OCM annontations are propagated.
 						Expect(vsPvc.Annotations).To(HaveKeyWithValue(
@@ -169,12 +178,45 @@ var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
 						// Except the do-no-delete annotion
 						Expect(vsPvc.Annotations).NotTo(HaveKey(volsync.ACMAppSubDoNotDeleteAnnotation))
 
+						// Configured annotations, such as an encryption key reference, are propagated.
+						Expect(vsPvc.Annotations).To(HaveKeyWithValue(pvcEncryptionAnnotation, "pvc-encryption-key"))
+
 						// Other annotations are droopped.
 						Expect(vsPvc.Annotations).NotTo(HaveKey("pv.kubernetes.io/bind-completed"))
 						Expect(vsPvc.Annotations).NotTo(HaveKey("volume.kubernetes.io/storage-provisioner"))
 					}
 				})
 
+				It("Should report the appsub ownership released when preparing for final sync", func() {
+					Eventually(func() error {
+						if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVsrg), testVsrg); err != nil {
+							return err
+						}
+
+						testVsrg.Spec.PrepareForFinalSync = true
+
+						return k8sClient.Update(testCtx, testVsrg)
+					}, testMaxWait, testInterval).Should(Succeed())
+
+					Eventually(func() int {
+						if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVsrg), testVsrg); err != nil {
+							return 0
+						}
+
+						released := 0
+
+						for _, vsPvc := range testVsrg.Status.ProtectedPVCs {
+							condition := meta.FindStatusCondition(vsPvc.Conditions,
+								controllers.VRGConditionTypeVolSyncAppsubOwnershipReleased)
+							if condition != nil && condition.Status == metav1.ConditionTrue {
+								released++
+							}
+						}
+
+						return released
+					}, testMaxWait, testInterval).Should(Equal(len(boundPvcs)))
+				})
+
 				Context("When RSSpec entries are added to vrg spec", func() {
 					It("Should create ReplicationSources for each", func() {
 						allRSs := &volsyncv1alpha1.ReplicationSourceList{}
@@ -346,6 +388,35 @@ var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
 					Expect(*rd1.Spec.RsyncTLS.ServiceType).To(Equal(corev1.ServiceTypeClusterIP))
 				})
 
+				It("Should recreate the ServiceExport of a ReplicationDestination when it is deleted", func() {
+					svcExport := &unstructured.Unstructured{}
+					svcExport.SetGroupVersionKind(schema.GroupVersionKind{
+						Group:   volsync.ServiceExportGroup,
+						Kind:    volsync.ServiceExportKind,
+						Version: volsync.ServiceExportVersion,
+					})
+					svcExportKey := types.NamespacedName{
+						Name:      "volsync-rsync-tls-dst-" + rd0.GetName(),
+						Namespace: rd0.GetNamespace(),
+					}
+
+					Eventually(func() error {
+						return k8sClient.Get(testCtx, svcExportKey, svcExport)
+					}, testMaxWait, testInterval).Should(Succeed())
+					Expect(svcExport.GetLabels()).To(HaveKeyWithValue(volsync.VRGOwnerNameLabel, testVrg.GetName()))
+
+					deletedUID := svcExport.GetUID()
+					Expect(k8sClient.Delete(testCtx, svcExport)).To(Succeed())
+
+					Eventually(func() bool {
+						if err := k8sClient.Get(testCtx, svcExportKey, svcExport); err != nil {
+							return false
+						}
+
+						return svcExport.GetUID() != deletedUID
+					}, testMaxWait, testInterval).Should(BeTrue())
+				})
+
 				Context("When ReplicationDestinations have address set in status", func() {
 					rd0Address := "99.98.97.96"
 					rd1Address := "99.88.77.66"
@@ -369,6 +440,286 @@ var _ = Describe("VolumeReplicationGroupVolSyncController", func() {
 			})
 		})
 	})
+
+	Describe("Primary takeover with deferred ReplicationDestination cleanup", func() {
+		testMatchLabels := map[string]string{
+			"ramentest": "backmeup",
+		}
+
+		const pvcName = "deferred-pvc"
+
+		var testVrg *ramendrv1alpha1.VolumeReplicationGroup
+
+		rdKey := func() types.NamespacedName {
+			return types.NamespacedName{Name: pvcName, Namespace: testNamespace.GetName()}
+		}
+
+		BeforeEach(func() {
+			ramenConfig.VolSync.DeferRDCleanup = true
+			configMapUpdate()
+			DeferCleanup(func() {
+				ramenConfig.VolSync.DeferRDCleanup = false
+				configMapUpdate()
+			})
+
+			storageClassName := testStorageClassName
+			capacity := corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}
+
+			testVrg = &ramendrv1alpha1.VolumeReplicationGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-vrg-east-",
+					Namespace:    testNamespace.GetName(),
+				},
+				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+					ReplicationState: ramendrv1alpha1.Secondary,
+					Async: &ramendrv1alpha1.VRGAsyncSpec{
+						SchedulingInterval: "1h",
+					},
+					PVCSelector: metav1.LabelSelector{
+						MatchLabels: testMatchLabels,
+					},
+					S3Profiles: []string{s3Profiles[0].S3ProfileName},
+					VolSync: ramendrv1alpha1.VolSyncSpec{
+						RDSpec: []ramendrv1alpha1.VolSyncReplicationDestinationSpec{{
+							ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
+								Name:               pvcName,
+								Namespace:          testNamespace.GetName(),
+								ProtectedByVolSync: true,
+								StorageClassName:   &storageClassName,
+								AccessModes:        []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								Labels:             testMatchLabels,
+								Resources:          corev1.VolumeResourceRequirements{Requests: capacity},
+							},
+						}},
+					},
+				},
+			}
+
+			createSC()
+			createVSC()
+
+			Expect(k8sClient.Create(testCtx, testVrg)).To(Succeed())
+			createSecret(testVrg.GetName(), testNamespace.Name)
+
+			// The secondary replicates into the RD, which VolSync reports the latest snapshot of
+			rd := &volsyncv1alpha1.ReplicationDestination{}
+			Eventually(func() error {
+				return k8sClient.Get(testCtx, rdKey(), rd)
+			}, testMaxWait, testInterval).Should(Succeed())
+
+			snapshotClassName := testVolumeSnapshotClass
+			snapshot := &snapv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: pvcName + "-snap", Namespace: testNamespace.GetName()},
+				Spec: snapv1.VolumeSnapshotSpec{
+					Source:                  snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: &rd.Name},
+					VolumeSnapshotClassName: &snapshotClassName,
+				},
+			}
+			Expect(k8sClient.Create(testCtx, snapshot)).To(Succeed())
+
+			readyToUse := true
+			snapshot.Status = &snapv1.VolumeSnapshotStatus{ReadyToUse: &readyToUse, RestoreSize: capacity.Storage()}
+			Expect(k8sClient.Status().Update(testCtx, snapshot)).To(Succeed())
+
+			snapshotAPIGroup := snapv1.GroupName
+			rdAddress := "99.98.97.96"
+			rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+				LatestImage: &corev1.TypedLocalObjectReference{
+					APIGroup: &snapshotAPIGroup,
+					Kind:     "VolumeSnapshot",
+					Name:     snapshot.GetName(),
+				},
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{Address: &rdAddress},
+			}
+			Expect(k8sClient.Status().Update(testCtx, rd)).To(Succeed())
+
+			// Take over as primary, the PVC being restored from the latest snapshot of the RD
+			Eventually(func() error {
+				if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVrg), testVrg); err != nil {
+					return err
+				}
+
+				testVrg.Spec.ReplicationState = ramendrv1alpha1.Primary
+				testVrg.Spec.Action = ramendrv1alpha1.VRGActionFailover
+
+				return k8sClient.Update(testCtx, testVrg)
+			}, testMaxWait, testInterval).Should(Succeed())
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Eventually(func() error {
+				return k8sClient.Get(testCtx, rdKey(), pvc)
+			}, testMaxWait, testInterval).Should(Succeed())
+			bindPVCToRunningPod(testCtx, pvc)
+		})
+
+		It("Should keep the ReplicationDestination alongside the ReplicationSource until the RDSpec is cleared", func() {
+			Eventually(func() error {
+				return k8sClient.Get(testCtx, rdKey(), &volsyncv1alpha1.ReplicationSource{})
+			}, testMaxWait, testInterval).Should(Succeed())
+
+			rd := &volsyncv1alpha1.ReplicationDestination{}
+			Consistently(func(g Gomega) {
+				g.Expect(k8sClient.Get(testCtx, rdKey(), rd)).To(Succeed())
+				g.Expect(rd.GetAnnotations()).To(HaveKey(volsync.TakeoverPendingAnnotation))
+			}, testMaxWait/4, testInterval).Should(Succeed())
+
+			// The hub confirms the takeover
+			Eventually(func() error {
+				if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVrg), testVrg); err != nil {
+					return err
+				}
+
+				testVrg.Spec.VolSync.RDSpec = nil
+
+				return k8sClient.Update(testCtx, testVrg)
+			}, testMaxWait, testInterval).Should(Succeed())
+
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Get(testCtx, rdKey(), rd))
+			}, testMaxWait, testInterval).Should(BeTrue())
+			Expect(k8sClient.Get(testCtx, rdKey(), &volsyncv1alpha1.ReplicationSource{})).To(Succeed())
+		})
+	})
+
+	Describe("Primary deletion with retained snapshots", func() {
+		testMatchLabels := map[string]string{
+			"ramentest": "backmeup",
+		}
+
+		var testVrg *ramendrv1alpha1.VolumeReplicationGroup
+
+		var snapshot *snapv1.VolumeSnapshot
+
+		BeforeEach(func() {
+			testVrg = &ramendrv1alpha1.VolumeReplicationGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-vrg-east-",
+					Namespace:    testNamespace.GetName(),
+				},
+				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+					ReplicationState: ramendrv1alpha1.Primary,
+					Async: &ramendrv1alpha1.VRGAsyncSpec{
+						SchedulingInterval: "1h",
+					},
+					PVCSelector: metav1.LabelSelector{
+						MatchLabels: testMatchLabels,
+					},
+					S3Profiles: []string{s3Profiles[0].S3ProfileName},
+				},
+			}
+
+			createSC()
+			createVSC()
+
+			Expect(k8sClient.Create(testCtx, testVrg)).To(Succeed())
+			createSecret(testVrg.GetName(), testNamespace.Name)
+
+			pvc := createPVCBoundToRunningPod(testCtx, testNamespace.GetName(), testMatchLabels, nil)
+
+			Eventually(func() error {
+				return k8sClient.Get(testCtx, client.ObjectKeyFromObject(pvc), &volsyncv1alpha1.ReplicationSource{})
+			}, testMaxWait, testInterval).Should(Succeed())
+			Expect(k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVrg), testVrg)).To(Succeed())
+
+			// A snapshot of the VRG, retained for an hour
+			snapshotClassName := testVolumeSnapshotClass
+			snapshot = &snapv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pvc.GetName() + "-retained",
+					Namespace: testNamespace.GetName(),
+					Labels: map[string]string{
+						volsync.VRGOwnerNameLabel:      testVrg.GetName(),
+						volsync.VRGOwnerNamespaceLabel: testVrg.GetNamespace(),
+					},
+					Annotations: map[string]string{
+						volsync.RetainUntilAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+					},
+				},
+				Spec: snapv1.VolumeSnapshotSpec{
+					Source:                  snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: &pvc.Name},
+					VolumeSnapshotClassName: &snapshotClassName,
+				},
+			}
+			Expect(controllerutil.SetOwnerReference(testVrg, snapshot, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(testCtx, snapshot)).To(Succeed())
+		})
+
+		It("Should keep the retained snapshot, no longer owned by the VRG, once the VRG is deleted", func() {
+			Expect(k8sClient.Delete(testCtx, testVrg)).To(Succeed())
+
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(testCtx, client.ObjectKeyFromObject(snapshot), snapshot)).To(Succeed())
+				g.Expect(snapshot.GetOwnerReferences()).NotTo(ContainElement(
+					HaveField("UID", testVrg.GetUID())))
+			}, testMaxWait, testInterval).Should(Succeed())
+
+			// The deletion of the VRG waits for the retention to expire
+			Consistently(func(g Gomega) {
+				g.Expect(k8sClient.Get(testCtx, client.ObjectKeyFromObject(snapshot), snapshot)).To(Succeed())
+				g.Expect(k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVrg), testVrg)).To(Succeed())
+			}, testMaxWait/4, testInterval).Should(Succeed())
+		})
+	})
+
+	Describe("Primary paused setup", func() {
+		testMatchLabels := map[string]string{
+			"ramentest": "backmeup",
+		}
+
+		var testVrg *ramendrv1alpha1.VolumeReplicationGroup
+
+		Context("When VRG created on primary with VolSync paused", func() {
+			JustBeforeEach(func() {
+				testVrg = &ramendrv1alpha1.VolumeReplicationGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						GenerateName: "test-vrg-east-",
+						Namespace:    testNamespace.GetName(),
+						Annotations: map[string]string{
+							controllers.VolSyncPausedAnnotation: controllers.VolSyncPausedAnnotationVal,
+						},
+					},
+					Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+						ReplicationState: ramendrv1alpha1.Primary,
+						Async: &ramendrv1alpha1.VRGAsyncSpec{
+							SchedulingInterval: "1h",
+						},
+						PVCSelector: metav1.LabelSelector{
+							MatchLabels: testMatchLabels,
+						},
+						S3Profiles: []string{s3Profiles[0].S3ProfileName},
+						VolSync:    ramendrv1alpha1.VolSyncSpec{},
+					},
+				}
+
+				Expect(k8sClient.Create(testCtx, testVrg)).To(Succeed())
+
+				createSecret(testVrg.GetName(), testNamespace.Name)
+				createSC()
+				createVSC()
+
+				createPVCBoundToRunningPod(testCtx, testNamespace.GetName(), testMatchLabels, nil)
+			})
+
+			It("Should report the Paused condition and not create ReplicationSources", func() {
+				Eventually(func() *metav1.Condition {
+					err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(testVrg), testVrg)
+					if err != nil {
+						return nil
+					}
+
+					return meta.FindStatusCondition(testVrg.Status.Conditions, controllers.VRGConditionTypeVolSyncPaused)
+				}, testMaxWait, testInterval).ShouldNot(BeNil())
+
+				allRSs := &volsyncv1alpha1.ReplicationSourceList{}
+				Consistently(func() int {
+					Expect(k8sClient.List(testCtx, allRSs,
+						client.InNamespace(testNamespace.GetName()))).To(Succeed())
+
+					return len(allRSs.Items)
+				}, testMaxWait/4, testInterval).Should(Equal(0))
+			})
+		})
+	})
 })
 
 //nolint:funlen
@@ -401,16 +752,23 @@ func createPVCBoundToRunningPod(ctx context.Context, namespace string,
 
 	Expect(k8sClient.Create(context.TODO(), pvc)).To(Succeed())
 
+	bindPVCToRunningPod(ctx, pvc)
+
+	return pvc
+}
+
+// bindPVCToRunningPod sets the PVC bound, and creates a running pod mounting it
+func bindPVCToRunningPod(ctx context.Context, pvc *corev1.PersistentVolumeClaim) {
 	pvc.Status.Phase = corev1.ClaimBound
-	pvc.Status.AccessModes = accessModes
-	pvc.Status.Capacity = capacity
+	pvc.Status.AccessModes = pvc.Spec.AccessModes
+	pvc.Status.Capacity = pvc.Spec.Resources.Requests
 	Expect(k8sClient.Status().Update(ctx, pvc)).To(Succeed())
 
 	// Create the pod which is mounting the pvc
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "test-mounting-pod-",
-			Namespace:    namespace,
+			Namespace:    pvc.GetNamespace(),
 		},
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
@@ -445,8 +803,6 @@ func createPVCBoundToRunningPod(ctx context.Context, namespace string,
 	}
 
 	Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
-
-	return pvc
 }
 
 func createSecret(vrgName, namespace string) {
@@ -4,11 +4,18 @@
 package deployers
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/e2e/util"
+	"github.com/ramendr/ramen/e2e/workloads"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
 	subscriptionv1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const FiveSecondsDuration = 5 * time.Second
@@ -46,3 +53,66 @@ func waitSubscriptionPhase(namespace, name string, phase subscriptionv1.Subscrip
 		time.Sleep(time.Second * time.Duration(util.TimeInterval))
 	}
 }
+
+// WaitProtectionReady waits until the primary VRG of the deployed workload reports its data protected, that is,
+// for VolSync, until every protected PVC has completed at least one sync. It gives up after util.Timeout or when
+// ctx is done, whichever happens first.
+func WaitProtectionReady(ctx context.Context, w workloads.Workload, d Deployer) error {
+	name := GetCombinedName(d, w)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(util.Timeout))
+	defer cancel()
+
+	return waitVRGDataProtected(ctx, []client.Client{util.Ctx.C1.CtrlClient, util.Ctx.C2.CtrlClient},
+		name, name, time.Second*time.Duration(util.TimeInterval))
+}
+
+func waitVRGDataProtected(ctx context.Context, clients []client.Client, namespace, name string,
+	interval time.Duration,
+) error {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+
+	for {
+		for _, c := range clients {
+			vrg := &ramen.VolumeReplicationGroup{}
+			if err := c.Get(ctx, key, vrg); err != nil {
+				if !errors.IsNotFound(err) {
+					util.Ctx.Log.Info(fmt.Sprintf("error to get vrg %s: %v", name, err))
+				}
+
+				continue
+			}
+
+			if vrg.Spec.ReplicationState == ramen.Primary && vrgDataProtected(vrg) {
+				util.Ctx.Log.Info("vrg " + name + " is data protected")
+
+				return nil
+			}
+		}
+
+		util.Ctx.Log.Info(fmt.Sprintf("vrg %s is not data protected yet, retry in %v", name, interval))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("vrg %s is not data protected yet before timeout: %w", name, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// vrgDataProtected mirrors the readiness used by the VolSync handler: the VRG DataProtected condition is current
+// and true, and every VolSync protected PVC has a last sync time
+func vrgDataProtected(vrg *ramen.VolumeReplicationGroup) bool {
+	condition := meta.FindStatusCondition(vrg.Status.Conditions, "DataProtected")
+	if condition == nil || condition.Status != "True" || condition.ObservedGeneration != vrg.Generation {
+		return false
+	}
+
+	for _, protectedPVC := range vrg.Status.ProtectedPVCs {
+		if protectedPVC.ProtectedByVolSync && protectedPVC.LastSyncTime == nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deployers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/deployers"
+	"github.com/ramendr/ramen/e2e/util"
+	"github.com/ramendr/ramen/e2e/workloads"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newVRG(name string, dataProtected bool) *ramen.VolumeReplicationGroup {
+	status := metav1.ConditionFalse
+	if dataProtected {
+		status = metav1.ConditionTrue
+	}
+
+	return &ramen.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: name},
+		Spec:       ramen.VolumeReplicationGroupSpec{ReplicationState: ramen.Primary},
+		Status: ramen.VolumeReplicationGroupStatus{
+			Conditions: []metav1.Condition{{
+				Type:               "DataProtected",
+				Status:             status,
+				Reason:             "Testing",
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+}
+
+func TestWaitProtectionReady(t *testing.T) {
+	w := workloads.Deployment{Name: "Deployment", AppName: "busybox"}
+	d := deployers.Subscription{}
+	name := deployers.GetCombinedName(d, w)
+
+	util.NewFakeContext(t, ramen.AddToScheme, newVRG(name, true))
+
+	if err := deployers.WaitProtectionReady(context.Background(), w, d); err != nil {
+		t.Errorf("expected vrg to be data protected: %v", err)
+	}
+}
+
+func TestWaitProtectionReadyTimeout(t *testing.T) {
+	w := workloads.Deployment{Name: "Deployment", AppName: "busybox"}
+	d := deployers.Subscription{}
+
+	util.NewFakeContext(t, ramen.AddToScheme, newVRG(deployers.GetCombinedName(d, w), false))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if err := deployers.WaitProtectionReady(ctx, w, d); err == nil {
+		t.Error("expected a timeout error for a vrg that is not data protected")
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected to give up when the context is done, waited %v", elapsed)
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// NewFakeContext sets Ctx to fake clients of both clusters, knowing the types added by addToScheme, for unit tests.
+// The client of the first cluster is created with objs.
+func NewFakeContext(t *testing.T, addToScheme func(*runtime.Scheme) error, objs ...client.Object) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := addToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	log := logr.Discard()
+	Ctx = &Context{
+		Log: &log,
+		C1:  Cluster{CtrlClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()},
+		C2:  Cluster{CtrlClient: fake.NewClientBuilder().WithScheme(scheme).Build()},
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PartitionNetworkPolicyName is the name of the NetworkPolicy used to partition the VolSync rsync path
+	PartitionNetworkPolicyName = "ramen-e2e-partition"
+
+	volSyncCreatedByLabelKey   = "app.kubernetes.io/created-by"
+	volSyncCreatedByLabelValue = "volsync"
+)
+
+// BlockVolSyncTraffic partitions the inter-cluster rsync path of the namespace, by denying all ingress and egress
+// traffic of the VolSync mover pods in it. The partition stays in place until UnblockVolSyncTraffic is called.
+func BlockVolSyncTraffic(client client.Client, namespace string) error {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PartitionNetworkPolicyName,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{volSyncCreatedByLabelKey: volSyncCreatedByLabelValue},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		},
+	}
+
+	err := client.Create(context.Background(), policy)
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+
+		Ctx.Log.Info("networkpolicy " + PartitionNetworkPolicyName + " already exists in namespace " + namespace)
+	} else {
+		Ctx.Log.Info("networkpolicy " + PartitionNetworkPolicyName + " is created in namespace " + namespace)
+	}
+
+	return nil
+}
+
+// UnblockVolSyncTraffic heals a partition created by BlockVolSyncTraffic
+func UnblockVolSyncTraffic(client client.Client, namespace string) error {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PartitionNetworkPolicyName,
+			Namespace: namespace,
+		},
+	}
+
+	err := client.Delete(context.Background(), policy)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+
+		Ctx.Log.Info("networkpolicy " + PartitionNetworkPolicyName + " not found in namespace " + namespace)
+	} else {
+		Ctx.Log.Info("networkpolicy " + PartitionNetworkPolicyName + " is deleted in namespace " + namespace)
+	}
+
+	return nil
+}
+
+// WithNetworkPartition blocks the VolSync traffic of the namespace on both managed clusters, runs fn, and heals
+// the partition again, whether or not fn succeeded. Tests can assert after it returns that Ramen recovers.
+func WithNetworkPartition(namespace string, fn func() error) error {
+	clusters := []Cluster{Ctx.C1, Ctx.C2}
+
+	for _, cluster := range clusters {
+		if err := BlockVolSyncTraffic(cluster.CtrlClient, namespace); err != nil {
+			_ = healPartition(clusters, namespace)
+
+			return fmt.Errorf("failed to partition namespace %s: %w", namespace, err)
+		}
+	}
+
+	fnErr := fn()
+
+	if err := healPartition(clusters, namespace); err != nil {
+		return fmt.Errorf("failed to heal partition of namespace %s: %w", namespace, err)
+	}
+
+	return fnErr
+}
+
+func healPartition(clusters []Cluster, namespace string) error {
+	var firstErr error
+
+	for _, cluster := range clusters {
+		if err := UnblockVolSyncTraffic(cluster.CtrlClient, namespace); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ramendr/ramen/e2e/util"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const namespace = "partitioned"
+
+func partitioned(t *testing.T, c client.Client) bool {
+	t.Helper()
+
+	key := types.NamespacedName{Namespace: namespace, Name: util.PartitionNetworkPolicyName}
+
+	err := c.Get(context.Background(), key, &networkingv1.NetworkPolicy{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		t.Fatal(err)
+	}
+
+	return err == nil
+}
+
+func TestBlockAndUnblockVolSyncTraffic(t *testing.T) {
+	util.NewFakeContext(t, networkingv1.AddToScheme)
+
+	c := util.Ctx.C1.CtrlClient
+
+	for i := 0; i < 2; i++ {
+		if err := util.BlockVolSyncTraffic(c, namespace); err != nil {
+			t.Fatalf("expected partition to be created: %v", err)
+		}
+	}
+
+	if !partitioned(t, c) {
+		t.Fatal("expected namespace to be partitioned")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := util.UnblockVolSyncTraffic(c, namespace); err != nil {
+			t.Fatalf("expected partition to be healed: %v", err)
+		}
+	}
+
+	if partitioned(t, c) {
+		t.Fatal("expected partition to be healed")
+	}
+}
+
+func TestWithNetworkPartition(t *testing.T) {
+	util.NewFakeContext(t, networkingv1.AddToScheme)
+
+	errFn := errors.New("failover failed")
+
+	err := util.WithNetworkPartition(namespace, func() error {
+		if !partitioned(t, util.Ctx.C1.CtrlClient) || !partitioned(t, util.Ctx.C2.CtrlClient) {
+			t.Error("expected both clusters to be partitioned")
+		}
+
+		return errFn
+	})
+	if !errors.Is(err, errFn) {
+		t.Errorf("expected the error of fn to be returned, got %v", err)
+	}
+
+	if partitioned(t, util.Ctx.C1.CtrlClient) || partitioned(t, util.Ctx.C2.CtrlClient) {
+		t.Error("expected partition to be healed on both clusters")
+	}
+}
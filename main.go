@@ -17,6 +17,7 @@ import (
 	volrep "github.com/csi-addons/kubernetes-csi-addons/apis/replication.storage/v1alpha1"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	clrapiv1beta1 "github.com/open-cluster-management-io/api/cluster/v1beta1"
+	ocmclv1 "github.com/open-cluster-management/api/cluster/v1"
 	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
 	viewv1beta1 "github.com/stolostron/multicloud-operators-foundation/pkg/apis/view/v1beta1"
 	plrv1 "github.com/stolostron/multicloud-operators-placementrule/pkg/apis/apps/v1"
@@ -107,6 +108,7 @@ func configureController(ramenConfig *ramendrv1alpha1.RamenConfig) error {
 		utilruntime.Must(gppv1.AddToScheme(scheme))
 		utilruntime.Must(argocdv1alpha1hack.AddToScheme(scheme))
 		utilruntime.Must(clrapiv1beta1.AddToScheme(scheme))
+		utilruntime.Must(ocmclv1.AddToScheme(scheme))
 		utilruntime.Must(recipe.AddToScheme(scheme))
 	} else {
 		utilruntime.Must(velero.AddToScheme(scheme))
@@ -175,6 +177,10 @@ func setupReconcilersHub(mgr ctrl.Manager) {
 		Log:               ctrl.Log.WithName("controllers").WithName("DRPolicy"),
 		Scheme:            mgr.GetScheme(),
 		ObjectStoreGetter: controllers.S3ObjectStoreGetter(),
+		MCVGetter: rmnutil.ManagedClusterViewGetterImpl{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+		},
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DRPolicy")
 		os.Exit(1)